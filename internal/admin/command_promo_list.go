@@ -47,6 +47,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(serializedOut))
 	for _, code := range codes {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&humanOut{
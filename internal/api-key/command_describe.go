@@ -77,6 +77,7 @@ func (c *command) describe(cmd *cobra.Command, args []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	// Note that if more resource types are added with no logical clusters, then additional logic
 	// needs to be added here to determine the resource type.
 	for _, res := range resources {
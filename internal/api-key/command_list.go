@@ -114,6 +114,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	auditLogServiceAccountId := c.getAuditLogServiceAccountId()
 
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, apiKey := range apiKeys {
 		// ignore keys owned by Confluent-internal user (healthcheck, etc)
 		if !apiKey.Spec.HasOwner() {
@@ -54,6 +54,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(serializedOut))
 	for _, key := range keys {
 		var keyString string
 		switch {
@@ -116,13 +116,17 @@ func printDescribe(cmd *cobra.Command, meta *ScopedId) error {
 		return output.SerializedOutput(cmd, out)
 	}
 
-	if meta.ID != "" {
-		output.Printf(false, "Confluent Resource Name: %s\n", meta.ID)
-		output.Println(false, "")
+	if output.GetFormat(cmd) == output.Human {
+		if meta.ID != "" {
+			output.Printf(false, "Confluent Resource Name: %s\n", meta.ID)
+			output.Println(false, "")
+		}
+
+		output.Println(false, "Scope:")
 	}
 
-	output.Println(false, "Scope:")
 	list := output.NewList(cmd)
+	list.Columns(new(scopeOut))
 	for _, name := range types {
 		list.Add(&scopeOut{
 			Type: name,
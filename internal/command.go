@@ -32,6 +32,7 @@ import (
 	"github.com/confluentinc/cli/v3/internal/local"
 	"github.com/confluentinc/cli/v3/internal/login"
 	"github.com/confluentinc/cli/v3/internal/logout"
+	"github.com/confluentinc/cli/v3/internal/manifest"
 	"github.com/confluentinc/cli/v3/internal/organization"
 	"github.com/confluentinc/cli/v3/internal/pipeline"
 	"github.com/confluentinc/cli/v3/internal/plugin"
@@ -106,6 +107,7 @@ func NewConfluentCommand(cfg *config.Config) *cobra.Command {
 
 	cmd.AddCommand(admin.New(prerunner, cfg.IsTest))
 	cmd.AddCommand(apikey.New(prerunner, flagResolver))
+	cmd.AddCommand(manifest.NewApply(cfg, prerunner))
 	cmd.AddCommand(asyncapi.New(prerunner))
 	cmd.AddCommand(auditlog.New(prerunner))
 	cmd.AddCommand(billing.New(prerunner))
@@ -116,6 +118,7 @@ func NewConfluentCommand(cfg *config.Config) *cobra.Command {
 	cmd.AddCommand(configuration.New(cfg, prerunner))
 	cmd.AddCommand(context.New(prerunner, flagResolver))
 	cmd.AddCommand(connect.New(cfg, prerunner))
+	cmd.AddCommand(manifest.NewDiff(cfg, prerunner))
 	cmd.AddCommand(environment.New(prerunner))
 	cmd.AddCommand(feedback.New(prerunner))
 	cmd.AddCommand(iam.New(cfg, prerunner))
@@ -33,12 +33,21 @@ func newClusterCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comm
 		cmd.AddCommand(c.newDeleteCommand())
 		cmd.AddCommand(c.newDescribeCommand())
 		cmd.AddCommand(c.newListCommand())
+		cmd.AddCommand(c.newOffsetCommand())
 		cmd.AddCommand(c.newPauseCommand())
+		cmd.AddCommand(c.newRestartCommand())
 		cmd.AddCommand(c.newResumeCommand())
 		cmd.AddCommand(c.newUpdateCommand())
+		cmd.AddCommand(c.newValidateCommand())
 	} else {
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
+		cmd.AddCommand(c.newCreateCommandOnPrem())
+		cmd.AddCommand(c.newDeleteCommandOnPrem())
+		cmd.AddCommand(c.newDescribeCommandOnPrem())
 		cmd.AddCommand(c.newListCommandOnPrem())
+		cmd.AddCommand(c.newPauseCommandOnPrem())
+		cmd.AddCommand(c.newResumeCommandOnPrem())
+		cmd.AddCommand(c.newUpdateCommandOnPrem())
 	}
 
 	return cmd
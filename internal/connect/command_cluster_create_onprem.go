@@ -0,0 +1,58 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/resource"
+)
+
+func (c *clusterCommand) newCreateCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "create",
+		Short:       "Create a connector.",
+		Long:        "Create a connector in a self-managed Connect cluster, with the REST API of one of its workers.",
+		Args:        cobra.NoArgs,
+		RunE:        c.createOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Create a connector with the Connect worker at http://localhost:8083.",
+				Code: "confluent connect cluster create --config-file config.json --url http://localhost:8083",
+			},
+		),
+	}
+
+	cmd.Flags().String("config-file", "", "JSON connector configuration file.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectRestSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("config-file"))
+
+	return cmd
+}
+
+func (c *clusterCommand) createOnPrem(cmd *cobra.Command, _ []string) error {
+	userConfigs, err := getConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.newConnectRestClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	connectorInfo, err := client.createConnector((*userConfigs)["name"], *userConfigs)
+	if err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, errors.CreatedResourceMsg, resource.Connector, connectorInfo.GetName())
+	return nil
+}
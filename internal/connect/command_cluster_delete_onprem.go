@@ -0,0 +1,55 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/deletion"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/resource"
+)
+
+func (c *clusterCommand) newDeleteCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "delete <name-1> [name-2] ... [name-n]",
+		Short:       "Delete one or more connectors.",
+		Long:        "Delete one or more connectors from a self-managed Connect cluster, with the REST API of one of its workers.",
+		Args:        cobra.MinimumNArgs(1),
+		RunE:        c.deleteOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Delete connector "my-connector" with the Connect worker at http://localhost:8083.`,
+				Code: "confluent connect cluster delete my-connector --url http://localhost:8083",
+			},
+		),
+	}
+
+	pcmd.AddForceFlag(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectRestSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) deleteOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.newConnectRestClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 1 {
+		err = deletion.ConfirmDeletionYesNo(cmd, deletion.DefaultYesNoPromptString(resource.Connector, args))
+	} else {
+		err = deletion.ConfirmDeletionWithString(cmd, fmt.Sprintf(errors.DeleteResourceConfirmMsg, resource.Connector, args[0], args[0]), args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = deletion.Delete(args, client.deleteConnector, resource.Connector)
+	return err
+}
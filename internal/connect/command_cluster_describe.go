@@ -1,6 +1,9 @@
 package connect
 
 import (
+	"sort"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
@@ -32,6 +35,7 @@ type taskDescribeOut struct {
 type serializedTasksOut struct {
 	TaskId int32  `json:"task_id" yaml:"task_id"`
 	State  string `json:"state" yaml:"state"`
+	Trace  string `json:"trace,omitempty" yaml:"trace,omitempty"`
 }
 
 type configDescribeOut struct {
@@ -60,9 +64,16 @@ func (c *clusterCommand) newDescribeCommand() *cobra.Command {
 			examples.Example{
 				Code: "confluent connect cluster describe lcc-123456 --cluster lkc-123456",
 			},
+			examples.Example{
+				Text: "Wait up to 10 minutes for a connector and its tasks to be running, printing their state changes.",
+				Code: "confluent connect cluster describe lcc-123456 --watch --timeout 10m",
+			},
 		),
 	}
 
+	cmd.Flags().Bool("watch", false, "Poll the connector and print the state changes of the connector and its tasks until they reach the target state.")
+	cmd.Flags().String("target-state", "RUNNING", `The state the connector and its tasks must reach when "--watch" is set, such as "RUNNING" or "PAUSED".`)
+	cmd.Flags().Duration("timeout", 5*time.Minute, `Exit with an error if the target state is not reached within this duration when "--watch" is set.`)
 	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
 	pcmd.AddContextFlag(cmd, c.CLICommand)
 	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
@@ -72,6 +83,10 @@ func (c *clusterCommand) newDescribeCommand() *cobra.Command {
 }
 
 func (c *clusterCommand) describe(cmd *cobra.Command, args []string) error {
+	if err := output.ValidateNotDelimited(cmd); err != nil {
+		return err
+	}
+
 	kafkaCluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
 	if err != nil {
 		return err
@@ -82,7 +97,17 @@ func (c *clusterCommand) describe(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	connector, err := c.V2Client.GetConnectorExpansionById(args[0], environmentId, kafkaCluster.ID)
+	watch, err := cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+
+	var connector *connectv1.ConnectV1ConnectorExpansion
+	if watch {
+		connector, err = c.watch(cmd, args[0], environmentId, kafkaCluster.ID)
+	} else {
+		connector, err = c.V2Client.GetConnectorExpansionById(args[0], environmentId, kafkaCluster.ID)
+	}
 	if err != nil {
 		return err
 	}
@@ -112,6 +137,7 @@ func printHumanDescribe(cmd *cobra.Command, connector *connectv1.ConnectV1Connec
 
 	output.Println(false, "Task Level Details")
 	list := output.NewList(cmd)
+	list.Columns(new(taskDescribeOut))
 	for _, task := range connector.Status.GetTasks() {
 		list.Add(&taskDescribeOut{
 			TaskId: task.GetId(),
@@ -126,6 +152,7 @@ func printHumanDescribe(cmd *cobra.Command, connector *connectv1.ConnectV1Connec
 
 	output.Println(false, "Configuration Details")
 	list = output.NewList(cmd)
+	list.Columns(new(configDescribeOut))
 	for name, value := range connector.Info.GetConfig() {
 		list.Add(&configDescribeOut{
 			Config: name,
@@ -138,12 +165,19 @@ func printHumanDescribe(cmd *cobra.Command, connector *connectv1.ConnectV1Connec
 func printSerializedDescribe(cmd *cobra.Command, connector *connectv1.ConnectV1ConnectorExpansion) error {
 	tasks := make([]serializedTasksOut, 0)
 	for _, task := range connector.Status.GetTasks() {
-		tasks = append(tasks, serializedTasksOut{TaskId: task.Id, State: task.State})
+		tasks = append(tasks, serializedTasksOut{TaskId: task.Id, State: task.State, Trace: task.GetMsg()})
 	}
 
-	configs := make([]serializedConfigsOut, 0)
-	for name, value := range connector.Info.GetConfig() {
-		configs = append(configs, serializedConfigsOut{Config: name, Value: value})
+	config := connector.Info.GetConfig()
+	names := make([]string, 0, len(config))
+	for name := range config {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	configs := make([]serializedConfigsOut, 0, len(names))
+	for _, name := range names {
+		configs = append(configs, serializedConfigsOut{Config: name, Value: config[name]})
 	}
 
 	out := &serializedDescribeOut{
@@ -0,0 +1,54 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *clusterCommand) newDescribeCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "describe <name>",
+		Short:       "Describe a connector.",
+		Long:        "Describe the connector and task level details of a connector in a self-managed Connect cluster, with the REST API of one of its workers.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        c.describeOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Describe connector "my-connector" with the Connect worker at http://localhost:8083.`,
+				Code: "confluent connect cluster describe my-connector --url http://localhost:8083",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectRestSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *clusterCommand) describeOnPrem(cmd *cobra.Command, args []string) error {
+	if err := output.ValidateNotDelimited(cmd); err != nil {
+		return err
+	}
+
+	client, err := c.newConnectRestClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	connector, err := client.getConnectorExpansion(args[0])
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		return printHumanDescribe(cmd, connector)
+	}
+
+	return printSerializedDescribe(cmd, connector)
+}
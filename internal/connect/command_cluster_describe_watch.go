@@ -0,0 +1,89 @@
+package connect
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+// watchPollInterval is the interval between polls of a connector while its state is watched.
+const watchPollInterval = 5 * time.Second
+
+// watch polls a connector until it and all of its tasks are in the target state, printing each state change to stderr.
+// It returns the connector in its final state, or an error once the timeout expires.
+func (c *clusterCommand) watch(cmd *cobra.Command, id, environmentId, kafkaClusterId string) (*connectv1.ConnectV1ConnectorExpansion, error) {
+	targetState, err := cmd.Flags().GetString("target-state")
+	if err != nil {
+		return nil, err
+	}
+	targetState = strings.ToUpper(targetState)
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(timeout)
+
+	states := make(map[string]string)
+	for {
+		connector, err := c.V2Client.GetConnectorExpansionById(id, environmentId, kafkaClusterId)
+		if err != nil {
+			return nil, err
+		}
+
+		c.printStateChanges(states, id, connector.GetStatus())
+
+		if hasReachedState(connector.GetStatus(), targetState) {
+			return connector, nil
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, fmt.Errorf(`connector "%s" did not reach state %s within %s`, id, targetState, timeout)
+		}
+		time.Sleep(min(watchPollInterval, remaining))
+	}
+}
+
+// printStateChanges prints the states of the connector and its tasks which differ from the previously seen states.
+func (c *clusterCommand) printStateChanges(states map[string]string, id string, status connectv1.ConnectV1ConnectorExpansionStatus) {
+	if state := status.Connector.GetState(); states["connector"] != state {
+		states["connector"] = state
+		output.ErrPrintf(c.Config.EnableColor, "Connector \"%s\" is %s.%s\n", id, state, formatTrace(status.Connector.GetTrace()))
+	}
+
+	for _, task := range status.GetTasks() {
+		key := fmt.Sprintf("task-%d", task.GetId())
+		if state := task.GetState(); states[key] != state {
+			states[key] = state
+			output.ErrPrintf(c.Config.EnableColor, "Task %d of connector \"%s\" is %s.%s\n", task.GetId(), id, state, formatTrace(task.GetMsg()))
+		}
+	}
+}
+
+func hasReachedState(status connectv1.ConnectV1ConnectorExpansionStatus, state string) bool {
+	if status.Connector.GetState() != state {
+		return false
+	}
+	for _, task := range status.GetTasks() {
+		if task.GetState() != state {
+			return false
+		}
+	}
+	return true
+}
+
+// formatTrace returns the first line of a stack trace, which holds the exception and its message.
+func formatTrace(trace string) string {
+	if trace == "" {
+		return ""
+	}
+	line, _, _ := strings.Cut(trace, "\n")
+	return " " + strings.TrimSpace(line)
+}
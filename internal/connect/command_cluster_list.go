@@ -51,6 +51,7 @@ func (c *clusterCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(connectOut))
 	for name, connector := range connectors {
 		list.Add(&connectOut{
 			Name:   name,
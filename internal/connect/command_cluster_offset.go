@@ -0,0 +1,101 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
+)
+
+// offsetsRequestPollInterval is the interval between checks of whether a request to alter or reset offsets is applied.
+const offsetsRequestPollInterval = 2 * time.Second
+
+type offsetOut struct {
+	Partition string `human:"Partition"`
+	Offset    string `human:"Offset"`
+}
+
+type serializedOffsetsOut struct {
+	Id         string                     `json:"id" yaml:"id"`
+	Name       string                     `json:"name" yaml:"name"`
+	Offsets    []ccloudv2.ConnectorOffset `json:"offsets" yaml:"offsets"`
+	ObservedAt string                     `json:"observed_at,omitempty" yaml:"observed_at,omitempty"`
+}
+
+func (c *clusterCommand) newOffsetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offset",
+		Short: "Manage connector offsets.",
+	}
+
+	cmd.AddCommand(c.newOffsetAlterCommand())
+	cmd.AddCommand(c.newOffsetDescribeCommand())
+	cmd.AddCommand(c.newOffsetResetCommand())
+
+	return cmd
+}
+
+// getConnectorName returns the name of a connector in the current or specified Kafka cluster, with the cluster and
+// environment, since the offsets of a connector are managed by its name.
+func (c *clusterCommand) getConnectorName(id string) (string, string, string, error) {
+	kafkaCluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	connector, err := c.V2Client.GetConnectorExpansionById(id, environmentId, kafkaCluster.ID)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return connector.Info.GetName(), environmentId, kafkaCluster.ID, nil
+}
+
+// requestOffsets submits a request to alter or reset the offsets of a connector, and waits until it is applied. It
+// returns an error if the request is still pending once the timeout expires.
+func (c *clusterCommand) requestOffsets(name, environmentId, kafkaClusterId string, request ccloudv2.ConnectorOffsetsRequest, timeout time.Duration) error {
+	status, err := c.V2Client.RequestConnectorOffsets(name, environmentId, kafkaClusterId, request)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for status.Status.Phase == "PENDING" {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf(`the offsets of connector "%s" were not applied within %s`, name, timeout)
+		}
+		time.Sleep(min(offsetsRequestPollInterval, remaining))
+
+		status, err = c.V2Client.GetConnectorOffsetsRequestStatus(name, environmentId, kafkaClusterId)
+		if err != nil {
+			return err
+		}
+	}
+
+	if status.Status.Phase == "FAILED" {
+		return fmt.Errorf(`failed to apply the offsets of connector "%s": %s`, name, status.Status.Message)
+	}
+
+	return nil
+}
+
+func addOffsetsTimeoutFlag(cmd *cobra.Command) {
+	cmd.Flags().Duration("timeout", 5*time.Minute, "Exit with an error if the offsets are not applied within this duration.")
+}
+
+func formatOffset(offset map[string]any) string {
+	out, err := json.Marshal(offset)
+	if err != nil {
+		return fmt.Sprint(offset)
+	}
+	return string(out)
+}
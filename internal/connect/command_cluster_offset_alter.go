@@ -0,0 +1,85 @@
+package connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *clusterCommand) newOffsetAlterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "alter <id>",
+		Short:             "Alter the offsets of a connector.",
+		Long:              "Alter the offsets of a paused connector. The offsets file has the format printed by \"confluent connect cluster offset describe --output json\", and only the partitions in the file are altered.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.offsetAlter,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Alter the offsets of connector "lcc-123456" to the offsets in file "offsets.json".`,
+				Code: "confluent connect cluster offset alter lcc-123456 --offsets-file offsets.json",
+			},
+		),
+	}
+
+	cmd.Flags().String("offsets-file", "", "JSON file with the partitions and offsets of the connector.")
+	addOffsetsTimeoutFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("offsets-file", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("offsets-file"))
+
+	return cmd
+}
+
+func (c *clusterCommand) offsetAlter(cmd *cobra.Command, args []string) error {
+	offsetsFile, err := cmd.Flags().GetString("offsets-file")
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(offsetsFile)
+	if err != nil {
+		return err
+	}
+
+	offsets := new(serializedOffsetsOut)
+	if err := json.Unmarshal(data, offsets); err != nil {
+		return fmt.Errorf(`failed to parse offsets file "%s": %w`, offsetsFile, err)
+	}
+	if len(offsets.Offsets) == 0 {
+		return fmt.Errorf(`offsets file "%s" does not contain any offsets`, offsetsFile)
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	name, environmentId, kafkaClusterId, err := c.getConnectorName(args[0])
+	if err != nil {
+		return err
+	}
+
+	request := ccloudv2.ConnectorOffsetsRequest{
+		Type:    "PATCH",
+		Offsets: offsets.Offsets,
+	}
+	if err := c.requestOffsets(name, environmentId, kafkaClusterId, request, timeout); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Altered the offsets of %d partition(s) for connector \"%s\".\n", len(offsets.Offsets), args[0])
+	return nil
+}
@@ -0,0 +1,69 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *clusterCommand) newOffsetDescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "describe <id>",
+		Short:             "Describe the offsets of a connector.",
+		Long:              "Describe the offsets of a connector. The format of the partitions and offsets depends on the connector.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.offsetDescribe,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Describe the offsets of connector "lcc-123456".`,
+				Code: "confluent connect cluster offset describe lcc-123456",
+			},
+			examples.Example{
+				Text: `Save the offsets of connector "lcc-123456" to a file, which can be edited and passed to "confluent connect cluster offset alter".`,
+				Code: "confluent connect cluster offset describe lcc-123456 --output json > offsets.json",
+			},
+		),
+	}
+
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *clusterCommand) offsetDescribe(cmd *cobra.Command, args []string) error {
+	name, environmentId, kafkaClusterId, err := c.getConnectorName(args[0])
+	if err != nil {
+		return err
+	}
+
+	offsets, err := c.V2Client.GetConnectorOffsets(name, environmentId, kafkaClusterId)
+	if err != nil {
+		return err
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		list := output.NewList(cmd)
+		list.Columns(new(offsetOut))
+		for _, offset := range offsets.Offsets {
+			list.Add(&offsetOut{
+				Partition: formatOffset(offset.Partition),
+				Offset:    formatOffset(offset.Offset),
+			})
+		}
+		return list.Print()
+	}
+
+	return output.SerializedOutput(cmd, &serializedOffsetsOut{
+		Id:         args[0],
+		Name:       name,
+		Offsets:    offsets.Offsets,
+		ObservedAt: offsets.Metadata.ObservedAt,
+	})
+}
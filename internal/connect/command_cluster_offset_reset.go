@@ -0,0 +1,62 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/deletion"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *clusterCommand) newOffsetResetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "reset <id>",
+		Short:             "Reset the offsets of a connector.",
+		Long:              "Reset the offsets of a paused connector, so that it starts from the beginning once it is resumed.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.offsetReset,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Reset the offsets of connector "lcc-123456".`,
+				Code: "confluent connect cluster offset reset lcc-123456",
+			},
+		),
+	}
+
+	pcmd.AddForceFlag(cmd)
+	addOffsetsTimeoutFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) offsetReset(cmd *cobra.Command, args []string) error {
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	name, environmentId, kafkaClusterId, err := c.getConnectorName(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := deletion.ConfirmDeletionYesNo(cmd, fmt.Sprintf(`Are you sure you want to reset the offsets of connector "%s"?`, args[0])); err != nil {
+		return err
+	}
+
+	if err := c.requestOffsets(name, environmentId, kafkaClusterId, ccloudv2.ConnectorOffsetsRequest{Type: "DELETE"}, timeout); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Reset the offsets of connector \"%s\".\n", args[0])
+	return nil
+}
@@ -0,0 +1,91 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *clusterCommand) newRestartCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "restart <id-1> [id-2] ... [id-N]",
+		Short:             "Restart connectors.",
+		Long:              "Restart connectors. By default only the connector instance is restarted; use \"--include-tasks\" to also restart its tasks, and \"--failed-only\" to only restart the instances which have failed.",
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgsMultiple),
+		RunE:              c.restart,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Restart connector "lcc-000001":`,
+				Code: "confluent connect cluster restart lcc-000001",
+			},
+			examples.Example{
+				Text: `Restart the failed tasks of connector "lcc-000001":`,
+				Code: "confluent connect cluster restart lcc-000001 --include-tasks --failed-only",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("failed-only", false, "Only restart the connector and tasks which have failed.")
+	cmd.Flags().Bool("include-tasks", false, "Restart the tasks of the connector as well as the connector.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) restart(cmd *cobra.Command, args []string) error {
+	failedOnly, err := cmd.Flags().GetBool("failed-only")
+	if err != nil {
+		return err
+	}
+
+	includeTasks, err := cmd.Flags().GetBool("include-tasks")
+	if err != nil {
+		return err
+	}
+
+	kafkaCluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	connectorsByName, err := c.V2Client.ListConnectorsWithExpansions(environmentId, kafkaCluster.ID, "id,info")
+	if err != nil {
+		return err
+	}
+
+	connectorsById := make(map[string]connectv1.ConnectV1ConnectorExpansion)
+	for _, connector := range connectorsByName {
+		connectorsById[connector.Id.GetId()] = connector
+	}
+
+	for _, id := range args {
+		connector, ok := connectorsById[id]
+		if !ok {
+			return fmt.Errorf(errors.UnknownConnectorIdErrorMsg, id)
+		}
+
+		if err := c.V2Client.RestartConnector(connector.Info.GetName(), environmentId, kafkaCluster.ID, failedOnly, includeTasks); err != nil {
+			return err
+		}
+
+		output.Printf(c.Config.EnableColor, "Restarted connector \"%s\".\n", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *clusterCommand) newResumeCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "resume <name-1> [name-2] ... [name-N]",
+		Short:       "Resume connectors.",
+		Long:        "Resume connectors in a self-managed Connect cluster, with the REST API of one of its workers.",
+		Args:        cobra.MinimumNArgs(1),
+		RunE:        c.resumeOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Resume connectors "my-connector-1" and "my-connector-2":`,
+				Code: "confluent connect cluster resume my-connector-1 my-connector-2 --url http://localhost:8083",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectRestSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	return cmd
+}
+
+func (c *clusterCommand) resumeOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.newConnectRestClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range args {
+		if err := client.resumeConnector(name); err != nil {
+			return err
+		}
+
+		output.Printf(c.Config.EnableColor, "Resumed connector \"%s\".\n", name)
+	}
+
+	return nil
+}
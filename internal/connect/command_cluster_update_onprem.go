@@ -0,0 +1,86 @@
+package connect
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/properties"
+	"github.com/confluentinc/cli/v3/pkg/resource"
+)
+
+func (c *clusterCommand) newUpdateCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "update <name>",
+		Short:       "Update a connector configuration.",
+		Long:        "Update the configuration of a connector in a self-managed Connect cluster, with the REST API of one of its workers.",
+		Args:        cobra.ExactArgs(1),
+		RunE:        c.updateOnPrem,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Increase the maximum number of tasks of connector "my-connector".`,
+				Code: "confluent connect cluster update my-connector --config tasks.max=3 --url http://localhost:8083",
+			},
+		),
+	}
+
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the connector being updated.`)
+	cmd.Flags().String("config-file", "", "JSON connector configuration file.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremConnectRestSet())
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *clusterCommand) updateOnPrem(cmd *cobra.Command, args []string) error {
+	client, err := c.newConnectRestClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	var userConfigs *map[string]string
+	if cmd.Flags().Changed("config") {
+		configs, err := cmd.Flags().GetStringSlice("config")
+		if err != nil {
+			return err
+		}
+		configMap, err := properties.ConfigFlagToMap(configs)
+		if err != nil {
+			return err
+		}
+
+		connector, err := client.getConnector(args[0])
+		if err != nil {
+			return err
+		}
+		currentConfigs := connector.GetConfig()
+
+		for name, value := range configMap {
+			currentConfigs[name] = value
+		}
+		userConfigs = &currentConfigs
+	} else if cmd.Flags().Changed("config-file") {
+		userConfigs, err = getConfig(cmd)
+		if err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("one of `--config` or `--config-file` must be specified")
+	}
+
+	if err := client.updateConnectorConfig(args[0], *userConfigs); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, errors.UpdatedResourceMsg, resource.Connector, args[0])
+	return nil
+}
@@ -0,0 +1,118 @@
+package connect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type validateOut struct {
+	Config        string `human:"Config" serialized:"config"`
+	Error         string `human:"Error" serialized:"error"`
+	AllowedValues string `human:"Allowed Values" serialized:"allowed_values,omitempty"`
+	Documentation string `human:"Documentation" serialized:"documentation"`
+}
+
+func (c *clusterCommand) newValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:         "validate",
+		Short:       "Validate a connector configuration.",
+		Long:        "Validate a connector configuration file against its connector plugin without creating the connector. The file is checked locally before it is sent to the plugin, and each error is listed with the configuration it belongs to. The command exits with an error if the configuration is invalid, so it can be used to check connector configuration files before they are applied.",
+		Args:        cobra.NoArgs,
+		RunE:        c.validate,
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Validate a connector configuration in the current or specified Kafka cluster context.",
+				Code: "confluent connect cluster validate --config-file config.json",
+			},
+			examples.Example{
+				Code: "confluent connect cluster validate --config-file config.json --cluster lkc-123456",
+			},
+		),
+	}
+
+	cmd.Flags().String("config-file", "", "JSON connector configuration file.")
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("config-file"))
+
+	return cmd
+}
+
+func (c *clusterCommand) validate(cmd *cobra.Command, _ []string) error {
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+
+	// The file is parsed and checked for the required configurations before anything is sent to Confluent Cloud.
+	userConfigs, err := getConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	kafkaCluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return err
+	}
+
+	plugin := (*userConfigs)["connector.class"]
+	reply, err := c.V2Client.ValidateConnectorPlugin(plugin, environmentId, kafkaCluster.ID, *userConfigs)
+	if err != nil {
+		return errors.NewWrapErrorWithSuggestions(err, fmt.Sprintf(`failed to validate configuration with connector plugin "%s"`, plugin), "To list available connector plugin types, use `confluent connect plugin list`.")
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(validateOut))
+	errorCount := 0
+	for _, config := range reply.GetConfigs() {
+		doc := config.Definition.GetDisplayName()
+		if config.Definition.GetDocumentation() != "" {
+			doc = config.Definition.GetDocumentation()
+		}
+
+		for _, configError := range config.Value.GetErrors() {
+			list.Add(&validateOut{
+				Config:        config.Value.GetName(),
+				Error:         configError,
+				AllowedValues: strings.Join(config.Value.GetRecommendedValues(), ", "),
+				Documentation: doc,
+			})
+			errorCount++
+		}
+	}
+
+	if errorCount == 0 {
+		if output.GetFormat(cmd) == output.Human {
+			output.Printf(c.Config.EnableColor, "Connector configuration file \"%s\" is valid.\n", configFile)
+			return nil
+		}
+		return list.Print()
+	}
+
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	return errors.NewErrorWithSuggestions(
+		fmt.Sprintf(`connector configuration file "%s" has %d error(s)`, configFile, errorCount),
+		"Fix the listed configurations and validate the file again.",
+	)
+}
@@ -40,6 +40,7 @@ func (c *customPluginCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(customPluginOutList))
 	for _, plugin := range plugins {
 		list.Add(&customPluginOutList{
 			Name: plugin.GetDisplayName(),
@@ -7,6 +7,7 @@ import (
 
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/hub"
 )
 
 type pluginCommand struct {
@@ -29,6 +30,10 @@ func newPluginCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comma
 		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
 
 		cmd.AddCommand(c.newInstallCommand())
+		cmd.AddCommand(c.newListCommandOnPrem())
+		cmd.AddCommand(c.newMirrorCommand())
+		cmd.AddCommand(c.newUninstallCommand())
+		cmd.AddCommand(c.newUpgradeCommand())
 	}
 
 	cmd.AddCommand(c.newDescribeCommand())
@@ -74,3 +79,46 @@ func (c *pluginCommand) getPlugins() ([]connectv1.InlineResponse2002, error) {
 
 	return c.V2Client.ListConnectorPlugins(environmentId, kafkaCluster.ID)
 }
+
+func addHubMirrorFlag(cmd *cobra.Command) {
+	cmd.Flags().String("hub-mirror", "", "The directory or URL of a Confluent Hub mirror to use instead of Confluent Hub. Mirrors are created with \"confluent connect plugin mirror sync\".")
+}
+
+func addHubUrlFlag(cmd *cobra.Command) {
+	cmd.Flags().String("hub-url", "", "The URL of the Confluent Hub API, such as a proxy of the API. Defaults to \"https://api.hub.confluent.io\".")
+}
+
+// getHubClient returns a client for the Confluent Hub mirror passed to "--hub-mirror", or for the Confluent Hub API at
+// "--hub-url" otherwise.
+func (c *pluginCommand) getHubClient(cmd *cobra.Command) (*hub.Client, error) {
+	mirror := ""
+	if cmd.Flags().Lookup("hub-mirror") != nil {
+		var err error
+		mirror, err = cmd.Flags().GetString("hub-mirror")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hubUrl, err := cmd.Flags().GetString("hub-url")
+	if err != nil {
+		return nil, err
+	}
+
+	if mirror == "" && hubUrl == "" {
+		return c.GetHubClient()
+	}
+
+	unsafeTrace, err := cmd.Flags().GetBool("unsafe-trace")
+	if err != nil {
+		return nil, err
+	}
+
+	if mirror != "" {
+		return hub.NewMirrorClient(mirror, c.Config.Version.UserAgent, unsafeTrace), nil
+	}
+
+	client := hub.NewClient(c.Config.Version.UserAgent, false, unsafeTrace)
+	client.URL = hubUrl
+	return client, nil
+}
@@ -60,6 +60,7 @@ func (c *pluginCommand) describe(cmd *cobra.Command, args []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(pluginDescribeOut))
 	list.Sort(false)
 
 	configs := reply.GetConfigs()
@@ -46,6 +46,10 @@ func (c *pluginCommand) newInstallCommand() *cobra.Command {
 				Text: "Install the latest version of the Datagen connector in a user-specified directory and update a worker configuration file.",
 				Code: "confluent connect plugin install confluentinc/kafka-connect-datagen:latest --plugin-directory $CONFLUENT_HOME/plugins --worker-configurations $CONFLUENT_HOME/etc/kafka/connect-distributed.properties",
 			},
+			examples.Example{
+				Text: "Install the latest version of the Datagen connector from a Confluent Hub mirror.",
+				Code: "confluent connect plugin install confluentinc/kafka-connect-datagen:latest --hub-mirror /mnt/hub-mirror",
+			},
 		),
 		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
 	}
@@ -53,9 +57,12 @@ func (c *pluginCommand) newInstallCommand() *cobra.Command {
 	cmd.Flags().String("plugin-directory", "", "The plugin installation directory. If not specified, a default will be selected based on your Confluent Platform installation.")
 	cmd.Flags().StringSlice("worker-configurations", []string{}, "A comma-separated list of paths to one or more Kafka Connect worker configuration files. Each worker file will be updated to load plugins from the plugin directory in addition to any prior directories.")
 	cmd.Flags().String("confluent-platform", "", "The path to a Confluent Platform archive installation. By default, this command will search for Confluent Platform installations in common locations.")
+	addHubMirrorFlag(cmd)
+	addHubUrlFlag(cmd)
 	pcmd.AddDryRunFlag(cmd)
 	cmd.Flags().Bool("force", false, "Proceed without user input.")
 
+	cmd.MarkFlagsMutuallyExclusive("hub-mirror", "hub-url")
 	cobra.CheckErr(cmd.MarkFlagDirname("plugin-directory"))
 
 	return cmd
@@ -76,7 +83,7 @@ func (c *pluginCommand) install(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("at most two of `--plugin-directory`, `--worker-configurations`, and `--confluent-platform` may be set")
 	}
 
-	client, err := c.GetHubClient()
+	client, err := c.getHubClient(cmd)
 	if err != nil {
 		return err
 	}
@@ -356,6 +363,20 @@ func (c *pluginCommand) installFromRemote(client *hub.Client, pluginManifest *cp
 		return err
 	}
 
+	if err := verifyArchiveChecksums(pluginManifest, archive); err != nil {
+		return err
+	}
+
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return fmt.Errorf("failed to open remote archive file %s: %w", archive, err)
+	}
+
+	return unzipPlugin(pluginManifest, zipReader.File, pluginDir)
+}
+
+// verifyArchiveChecksums checks the MD5 and SHA1 checksums of a downloaded archive against those in its manifest.
+func verifyArchiveChecksums(pluginManifest *cpstructs.Manifest, archive []byte) error {
 	checksumErrorMsg := `%s checksum for downloaded archive (%s) does not match checksum in manifest (%s) for plugin "%s"`
 	calculatedMd5Checksum := fmt.Sprintf("%x", md5.Sum(archive))
 	if calculatedMd5Checksum != pluginManifest.Archive.Md5 {
@@ -365,13 +386,7 @@ func (c *pluginCommand) installFromRemote(client *hub.Client, pluginManifest *cp
 	if calculatedSha1Checksum != pluginManifest.Archive.Sha1 {
 		return fmt.Errorf(checksumErrorMsg, "SHA1", calculatedSha1Checksum, pluginManifest.Archive.Sha1, pluginManifest.Name)
 	}
-
-	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
-	if err != nil {
-		return fmt.Errorf("failed to open remote archive file %s: %w", archive, err)
-	}
-
-	return unzipPlugin(pluginManifest, zipReader.File, pluginDir)
+	return nil
 }
 
 func unzipPlugin(pluginManifest *cpstructs.Manifest, zipFiles []*zip.File, pluginDir string) error {
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -139,6 +140,104 @@ func TestUpdateWorkerConfig(t *testing.T) {
 	require.Equal(t, value, "/usr/share/java, new-plugin-dir")
 }
 
+func TestRemoveFromPluginPath(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "worker-test")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	file, err := os.Create(fmt.Sprintf("%s/test.properties", tempDir))
+	require.NoError(t, err)
+	_, err = file.Write([]byte("plugin.path=/usr/share/java, /plugins, /plugins/acme-file-connector/lib"))
+	require.NoError(t, err)
+
+	// Dry run: reports the change but does not write it
+	changed, err := removeFromPluginPath(file.Name(), []string{"/plugins/acme-file-connector"}, true)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	workerConfig, err := properties.LoadFile(file.Name(), properties.UTF8)
+	require.NoError(t, err)
+
+	value, ok := workerConfig.Get("plugin.path")
+	require.True(t, ok)
+	require.Equal(t, "/usr/share/java, /plugins, /plugins/acme-file-connector/lib", value)
+
+	// Actual run
+	changed, err = removeFromPluginPath(file.Name(), []string{"/plugins/acme-file-connector"}, false)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	workerConfig, err = properties.LoadFile(file.Name(), properties.UTF8)
+	require.NoError(t, err)
+
+	value, ok = workerConfig.Get("plugin.path")
+	require.True(t, ok)
+	require.Equal(t, "/usr/share/java, /plugins", value)
+
+	// Nothing left to remove
+	changed, err = removeFromPluginPath(file.Name(), []string{"/plugins/acme-file-connector"}, false)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
+
+func TestFindInstalledPlugins(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "plugin-test")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(tempDir)
+	}()
+
+	for _, dir := range []string{"confluentinc-b", "confluentinc-a", "not-a-plugin"} {
+		require.NoError(t, os.Mkdir(filepath.Join(tempDir, dir), 0755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "confluentinc-a", "manifest.json"), []byte(`{"name": "a", "version": "1.0.0", "owner": {"username": "confluentinc"}}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "confluentinc-b", "manifest.json"), []byte(`{"name": "b", "version": "2.0.0", "owner": {"username": "confluentinc"}}`), 0644))
+
+	plugins, err := findInstalledPlugins([]string{tempDir})
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	require.Equal(t, "confluentinc/a", plugins[0].id())
+	require.Equal(t, filepath.Join(tempDir, "confluentinc-a"), plugins[0].Directory)
+	require.Equal(t, "confluentinc/b", plugins[1].id())
+	require.Equal(t, "2.0.0", plugins[1].Manifest.Version)
+
+	filtered, err := filterInstalledPlugins(plugins, []string{"confluentinc/b"})
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	require.Equal(t, "confluentinc/b", filtered[0].id())
+
+	_, err = filterInstalledPlugins(plugins, []string{"confluentinc/c"})
+	require.Error(t, err)
+}
+
+func TestIsEmptyAfterUninstall(t *testing.T) {
+	tempDir := t.TempDir()
+
+	uninstalled := filepath.Join(tempDir, "confluentinc-a")
+	require.NoError(t, os.Mkdir(uninstalled, 0755))
+	require.NoError(t, os.Mkdir(filepath.Join(tempDir, "manual-connector"), 0755))
+
+	empty, err := isEmptyAfterUninstall(tempDir, []string{uninstalled})
+	require.NoError(t, err)
+	require.False(t, empty)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(tempDir, "manual-connector")))
+
+	empty, err = isEmptyAfterUninstall(tempDir, []string{uninstalled})
+	require.NoError(t, err)
+	require.True(t, empty)
+}
+
+func TestIsNewerVersion(t *testing.T) {
+	require.True(t, isNewerVersion("0.1.0", "0.0.5"))
+	require.True(t, isNewerVersion("10.7.10", "10.7.4"))
+	require.False(t, isNewerVersion("0.1.0", "10.7.4"))
+	require.False(t, isNewerVersion("1.0.0", "1.0.0"))
+	require.True(t, isNewerVersion("latest", "1.0.0"))
+}
+
 func TestUnzipPlugin(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "zip-test")
 	require.NoError(t, err)
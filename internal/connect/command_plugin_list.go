@@ -46,6 +46,7 @@ func (c *pluginCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(pluginListOut))
 	for _, plugin := range plugins {
 		list.Add(&pluginListOut{
 			Class: plugin.Class,
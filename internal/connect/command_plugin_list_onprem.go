@@ -0,0 +1,88 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type installedPluginOut struct {
+	Plugin    string `human:"Plugin" serialized:"plugin"`
+	Title     string `human:"Title" serialized:"title"`
+	Version   string `human:"Version" serialized:"version"`
+	Directory string `human:"Directory" serialized:"directory"`
+}
+
+func (c *pluginCommand) newListCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List installed Connect plugins.",
+		Long:  "List the Connect plugins installed from Confluent Hub, by reading the manifest of each plugin in the plugin directories of your local Confluent Platform installations.",
+		Args:  cobra.NoArgs,
+		RunE:  c.listOnPrem,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "List the plugins installed in your local Confluent Platform installations.",
+				Code: "confluent connect plugin list --installed",
+			},
+			examples.Example{
+				Text: "List the plugins installed in a plugin directory.",
+				Code: "confluent connect plugin list --installed --plugin-directory $CONFLUENT_HOME/plugins",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+	}
+
+	cmd.Flags().Bool("installed", false, "List the plugins installed locally.")
+	addInstalledPluginFlags(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("installed"))
+
+	return cmd
+}
+
+func (c *pluginCommand) listOnPrem(cmd *cobra.Command, _ []string) error {
+	plugins, err := getInstalledPlugins(cmd, nil)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(installedPluginOut))
+	for _, plugin := range plugins {
+		list.Add(&installedPluginOut{
+			Plugin:    plugin.id(),
+			Title:     plugin.Manifest.Title,
+			Version:   plugin.Manifest.Version,
+			Directory: plugin.Directory,
+		})
+	}
+	return list.Print()
+}
+
+func addInstalledPluginFlags(cmd *cobra.Command) {
+	cmd.Flags().String("plugin-directory", "", "The plugin installation directory. If not specified, the plugin directories of your Confluent Platform installations are searched.")
+	cmd.Flags().String("confluent-platform", "", "The path to a Confluent Platform archive installation. By default, this command will search for Confluent Platform installations in common locations.")
+
+	cobra.CheckErr(cmd.MarkFlagDirname("plugin-directory"))
+
+	cmd.MarkFlagsMutuallyExclusive("plugin-directory", "confluent-platform")
+}
+
+// getInstalledPlugins returns the plugins with the given IDs, or all plugins, installed in the plugin directories.
+func getInstalledPlugins(cmd *cobra.Command, ids []string) ([]installedPlugin, error) {
+	pluginDirs, err := getPluginDirs(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	plugins, err := findInstalledPlugins(pluginDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterInstalledPlugins(plugins, ids)
+}
@@ -0,0 +1,17 @@
+package connect
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func (c *pluginCommand) newMirrorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mirror",
+		Short: "Manage Confluent Hub mirrors.",
+		Long:  "Manage Confluent Hub mirrors, which store Connect plugins from Confluent Hub in a directory so that they can be installed in environments without access to Confluent Hub.",
+	}
+
+	cmd.AddCommand(c.newMirrorSyncCommand())
+
+	return cmd
+}
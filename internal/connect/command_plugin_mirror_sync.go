@@ -0,0 +1,152 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/cpstructs"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/hub"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+const (
+	mirrorStatusDownloaded = "Downloaded"
+	mirrorStatusUpToDate   = "Up to date"
+)
+
+type mirrorSyncOut struct {
+	Plugin  string `human:"Plugin" serialized:"plugin"`
+	Version string `human:"Version" serialized:"version"`
+	Archive string `human:"Archive" serialized:"archive"`
+	Status  string `human:"Status" serialized:"status"`
+}
+
+func (c *pluginCommand) newMirrorSyncCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync <plugin-1> [plugin-2] ... [plugin-n]",
+		Short: "Download Connect plugins from Confluent Hub into a mirror.",
+		Long:  "Download the manifests and archives of Connect plugins from Confluent Hub into a mirror directory. Plugins are specified with the format `<owner>/<name>[:<version>]`, where the version defaults to the latest version. Archives which are already in the mirror with the checksums of their manifest are not downloaded again. The mirror directory can be copied to, or served over HTTP in, an environment without access to Confluent Hub, and passed to `confluent connect plugin install --hub-mirror`.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  c.mirrorSync,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Download the latest versions of the Datagen and JDBC connectors into a mirror.",
+				Code: "confluent connect plugin mirror sync confluentinc/kafka-connect-datagen confluentinc/kafka-connect-jdbc --dir /mnt/hub-mirror",
+			},
+			examples.Example{
+				Text: "Download a specific version of the Datagen connector into a mirror.",
+				Code: "confluent connect plugin mirror sync confluentinc/kafka-connect-datagen:0.6.2 --dir /mnt/hub-mirror",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+	}
+
+	cmd.Flags().String("dir", "", "The directory of the mirror. It is created if it does not exist.")
+	addHubUrlFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("dir"))
+	cobra.CheckErr(cmd.MarkFlagDirname("dir"))
+
+	return cmd
+}
+
+func (c *pluginCommand) mirrorSync(cmd *cobra.Command, args []string) error {
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	client, err := c.getHubClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf(`failed to create mirror directory "%s": %w`, dir, err)
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(mirrorSyncOut))
+	for _, arg := range args {
+		owner, name, version, err := parseMirrorPluginId(arg)
+		if err != nil {
+			return err
+		}
+
+		pluginManifest, err := client.GetRemoteManifest(owner, name, version)
+		if err != nil {
+			return err
+		}
+
+		status, err := syncMirrorArchive(client, dir, pluginManifest)
+		if err != nil {
+			return err
+		}
+
+		if err := hub.WriteMirrorManifests(dir, pluginManifest, version == "latest"); err != nil {
+			return fmt.Errorf(`failed to write manifest of plugin "%s/%s" to mirror: %w`, owner, name, err)
+		}
+
+		list.Add(&mirrorSyncOut{
+			Plugin:  fmt.Sprintf("%s/%s", pluginManifest.Owner.Username, pluginManifest.Name),
+			Version: pluginManifest.Version,
+			Archive: hub.MirrorArchivePath(pluginManifest),
+			Status:  status,
+		})
+	}
+	return list.Print()
+}
+
+// parseMirrorPluginId parses a plugin ID with the format "<owner>/<name>[:<version>]", where the version defaults to the latest version.
+func parseMirrorPluginId(plugin string) (string, string, string, error) {
+	if !strings.Contains(plugin, ":") {
+		plugin += ":latest"
+	}
+
+	owner, name, version, err := parsePluginId(plugin)
+	if err != nil {
+		return "", "", "", errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`invalid plugin ID "%s"`, strings.TrimSuffix(plugin, ":latest")),
+			"Provide a plugin ID from Confluent Hub with the format: `<owner>/<name>[:<version>]`.",
+		)
+	}
+
+	return owner, name, version, nil
+}
+
+// syncMirrorArchive downloads the archive of a plugin into the mirror, unless the archive in the mirror already matches
+// the checksums of the manifest. The checksums of a downloaded archive are verified before it is written.
+func syncMirrorArchive(client *hub.Client, dir string, pluginManifest *cpstructs.Manifest) (string, error) {
+	archivePath := filepath.Join(dir, filepath.FromSlash(hub.MirrorArchivePath(pluginManifest)))
+
+	if archive, err := os.ReadFile(archivePath); err == nil && verifyArchiveChecksums(pluginManifest, archive) == nil {
+		return mirrorStatusUpToDate, nil
+	}
+
+	archive, err := client.GetRemoteArchive(pluginManifest)
+	if err != nil {
+		return "", err
+	}
+
+	if err := verifyArchiveChecksums(pluginManifest, archive); err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(archivePath), 0755); err != nil {
+		return "", fmt.Errorf(`failed to create directory "%s": %w`, filepath.Dir(archivePath), err)
+	}
+
+	if err := os.WriteFile(archivePath, archive, 0644); err != nil {
+		return "", fmt.Errorf(`failed to write archive of plugin "%s" to mirror: %w`, pluginManifest.Name, err)
+	}
+
+	return mirrorStatusDownloaded, nil
+}
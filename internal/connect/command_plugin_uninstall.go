@@ -0,0 +1,153 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/deletion"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+func (c *pluginCommand) newUninstallCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall <owner/name>",
+		Short: "Uninstall a Connect plugin.",
+		Long:  "Uninstall a Connect plugin installed from Confluent Hub. The plugin directory is removed, along with any entries of the plugin path of the worker configuration files which only refer to the plugin.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.uninstall,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Uninstall the Datagen connector from your local Confluent Platform environment.",
+				Code: "confluent connect plugin uninstall confluentinc/kafka-connect-datagen",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+	}
+
+	addInstalledPluginFlags(cmd)
+	cmd.Flags().StringSlice("worker-configurations", []string{}, "A comma-separated list of paths to one or more Kafka Connect worker configuration files to remove the plugin from. If not specified, the standard worker configuration files of your Confluent Platform installations are updated.")
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddForceFlag(cmd)
+
+	return cmd
+}
+
+func (c *pluginCommand) uninstall(cmd *cobra.Command, args []string) error {
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	plugins, err := getInstalledPlugins(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	workerConfigs, err := getWorkerConfigsFromFlag(cmd)
+	if err != nil {
+		return err
+	}
+	if len(workerConfigs) == 0 {
+		if workerConfigs, err = getAllStandardWorkerConfigs(cmd); err != nil {
+			return err
+		}
+	}
+
+	directories := make([]string, len(plugins))
+	for i, plugin := range plugins {
+		directories[i] = plugin.Directory
+	}
+
+	promptMsg := fmt.Sprintf("Are you sure you want to uninstall plugin \"%s\" from the following directories?\n\t* %s", args[0], strings.Join(directories, "\n\t* "))
+	if err := deletion.ConfirmDeletionYesNo(cmd, promptMsg); err != nil {
+		return err
+	}
+
+	for _, plugin := range plugins {
+		msg := fmt.Sprintf("Removed plugin directory \"%s\".\n", plugin.Directory)
+		if dryRun {
+			output.Printf(c.Config.EnableColor, utils.AddDryRunPrefix(msg))
+			continue
+		}
+		if err := os.RemoveAll(plugin.Directory); err != nil {
+			return err
+		}
+		output.Printf(c.Config.EnableColor, msg)
+	}
+
+	// Plugin directories which no longer contain any plugins are removed from the plugin path, along with the directories of the plugin.
+	removedDirs := slices.Clone(directories)
+	for _, plugin := range plugins {
+		empty, err := isEmptyAfterUninstall(plugin.PluginDir, directories)
+		if err != nil {
+			return err
+		}
+		if empty {
+			removedDirs = append(removedDirs, plugin.PluginDir)
+		}
+	}
+
+	for _, workerConfig := range workerConfigs {
+		changed, err := removeFromPluginPath(workerConfig, removedDirs, dryRun)
+		if err != nil {
+			return err
+		}
+		if changed {
+			msg := fmt.Sprintf("Removed the plugin from the plugin path of worker configuration file \"%s\".\n", workerConfig)
+			if dryRun {
+				msg = utils.AddDryRunPrefix(msg)
+			}
+			output.Printf(c.Config.EnableColor, msg)
+		}
+	}
+
+	msg := fmt.Sprintf("Uninstalled plugin \"%s\".\n", args[0])
+	if dryRun {
+		msg = utils.AddDryRunPrefix(msg)
+	}
+	output.Printf(c.Config.EnableColor, msg)
+	return nil
+}
+
+// isEmptyAfterUninstall returns whether a plugin directory contains nothing but the plugins being uninstalled. Plugins
+// installed by hand have no manifest, so every entry of the directory is checked rather than only the installed plugins.
+func isEmptyAfterUninstall(pluginDir string, uninstalledDirs []string) (bool, error) {
+	entries, err := os.ReadDir(pluginDir)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(pluginDir, entry.Name())
+		if !slices.ContainsFunc(uninstalledDirs, func(dir string) bool { return isSameOrInsideDir(path, dir) }) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// getAllStandardWorkerConfigs returns the standard worker configurations of every Confluent Platform installation.
+func getAllStandardWorkerConfigs(cmd *cobra.Command) ([]string, error) {
+	installations, err := getPlatformInstallations(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var workerConfigs []string
+	for _, installation := range installations {
+		paths, err := getStandardWorkerConfigs(&installation)
+		if err != nil {
+			return nil, err
+		}
+		workerConfigs = append(workerConfigs, paths...)
+	}
+	return workerConfigs, nil
+}
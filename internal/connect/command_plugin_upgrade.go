@@ -0,0 +1,229 @@
+package connect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-version"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/cpstructs"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/form"
+	"github.com/confluentinc/cli/v3/pkg/hub"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const (
+	upgradeStatusUpToDate    = "Up to date"
+	upgradeStatusAvailable   = "Upgrade available"
+	upgradeStatusNotFound    = "Not found on Confluent Hub"
+	upgradeStatusNotInMirror = "Not found in mirror"
+)
+
+type pluginUpgradeOut struct {
+	Plugin           string `human:"Plugin" serialized:"plugin"`
+	InstalledVersion string `human:"Installed Version" serialized:"installed_version"`
+	LatestVersion    string `human:"Latest Version" serialized:"latest_version"`
+	Status           string `human:"Status" serialized:"status"`
+}
+
+func (c *pluginCommand) newUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade [owner/name-1] [owner/name-2] ... [owner/name-N]",
+		Short: "Upgrade installed Connect plugins.",
+		Long:  "Upgrade Connect plugins installed from Confluent Hub to their latest versions. The manifest of each installed plugin is compared with the latest version on Confluent Hub, and each outdated plugin is reinstalled in its plugin directory. If no plugins are specified, all installed plugins are checked. With `--dry-run`, the available upgrades are listed without prompting, and with a serialized output format, plugins are only upgraded with `--force`.",
+		RunE:  c.upgrade,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: "Check which installed plugins have newer versions on Confluent Hub.",
+				Code: "confluent connect plugin upgrade --dry-run",
+			},
+			examples.Example{
+				Text: "Upgrade the Datagen connector to its latest version.",
+				Code: "confluent connect plugin upgrade confluentinc/kafka-connect-datagen",
+			},
+		),
+		Annotations: map[string]string{pcmd.RunRequirement: pcmd.RequireOnPremLogin},
+	}
+
+	addInstalledPluginFlags(cmd)
+	addHubMirrorFlag(cmd)
+	addHubUrlFlag(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	cmd.Flags().Bool("force", false, "Proceed without user input.")
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsMutuallyExclusive("hub-mirror", "hub-url")
+
+	return cmd
+}
+
+func (c *pluginCommand) upgrade(cmd *cobra.Command, args []string) error {
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
+	force, err := cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+
+	isHuman := output.GetFormat(cmd) == output.Human
+	if !isHuman && !dryRun && !force {
+		return errors.NewErrorWithSuggestions(
+			"cannot prompt to upgrade plugins with a serialized output format",
+			"Pass `--force` to upgrade plugins without prompting, or `--dry-run` to only list the available upgrades.",
+		)
+	}
+
+	plugins, err := getInstalledPlugins(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(plugins) == 0 && isHuman {
+		output.Println(c.Config.EnableColor, "No installed plugins found.")
+		return nil
+	}
+
+	client, err := c.getHubClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	notFoundStatus := upgradeStatusNotFound
+	if client.Mirror != "" {
+		notFoundStatus = upgradeStatusNotInMirror
+	}
+
+	list := output.NewList(cmd)
+	latestManifests := make([]*cpstructs.Manifest, len(plugins))
+	for i, plugin := range plugins {
+		out := &pluginUpgradeOut{
+			Plugin:           plugin.id(),
+			InstalledVersion: plugin.Manifest.Version,
+			Status:           notFoundStatus,
+		}
+
+		latestManifest, err := client.GetRemoteManifest(plugin.Manifest.Owner.Username, plugin.Manifest.Name, "latest")
+		if err != nil && !hub.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			out.LatestVersion = latestManifest.Version
+			out.Status = upgradeStatusUpToDate
+			if isNewerVersion(latestManifest.Version, plugin.Manifest.Version) {
+				out.Status = upgradeStatusAvailable
+				latestManifests[i] = latestManifest
+			}
+		}
+
+		list.Add(out)
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	prompt := form.NewPrompt()
+	for i, plugin := range plugins {
+		latestManifest := latestManifests[i]
+		if latestManifest == nil {
+			continue
+		}
+
+		upgradeStr := fmt.Sprintf("Upgraded %s from %s to %s.\n", plugin.Manifest.Title, plugin.Manifest.Version, latestManifest.Version)
+		if dryRun {
+			if isHuman {
+				output.Println(c.Config.EnableColor, "")
+				output.Printf(c.Config.EnableColor, utils.AddDryRunPrefix(upgradeStr))
+			}
+			continue
+		}
+
+		// With a serialized output format, plugins are only upgraded with --force, which implicitly accepts their licenses.
+		if !isHuman {
+			if err := c.upgradePlugin(client, &plugin, latestManifest); err != nil {
+				return err
+			}
+			continue
+		}
+
+		output.Println(c.Config.EnableColor, "")
+		if !force {
+			f := form.New(form.Field{
+				ID:        "confirm",
+				Prompt:    fmt.Sprintf("Do you want to upgrade %s from %s to %s?", plugin.Manifest.Title, plugin.Manifest.Version, latestManifest.Version),
+				IsYesOrNo: true,
+			})
+			if err := f.Prompt(prompt); err != nil {
+				return err
+			}
+			if !f.Responses["confirm"].(bool) {
+				continue
+			}
+		}
+
+		if err := checkLicenseAcceptance(latestManifest, prompt, force); err != nil {
+			return err
+		}
+
+		if err := c.upgradePlugin(client, &plugin, latestManifest); err != nil {
+			return err
+		}
+		output.Printf(c.Config.EnableColor, upgradeStr)
+	}
+
+	return nil
+}
+
+// upgradePlugin downloads, verifies, and extracts the latest version of a plugin to a temporary directory inside its
+// plugin directory, and only then replaces the installed version. The installed version is restored if it cannot be
+// replaced.
+func (c *pluginCommand) upgradePlugin(client *hub.Client, plugin *installedPlugin, latestManifest *cpstructs.Manifest) error {
+	stagingDir, err := os.MkdirTemp(plugin.PluginDir, ".upgrade-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(stagingDir)
+	}()
+
+	if err := c.installFromRemote(client, latestManifest, stagingDir); err != nil {
+		return err
+	}
+
+	directoryName := fmt.Sprintf("%s-%s", latestManifest.Owner.Username, latestManifest.Name)
+	previousDir := filepath.Join(stagingDir, "previous")
+	if err := os.Rename(plugin.Directory, previousDir); err != nil {
+		return fmt.Errorf(`failed to move plugin directory "%s": %w`, plugin.Directory, err)
+	}
+
+	installationDir := filepath.Join(plugin.PluginDir, directoryName)
+	if err := os.Rename(filepath.Join(stagingDir, directoryName), installationDir); err != nil {
+		if restoreErr := os.Rename(previousDir, plugin.Directory); restoreErr != nil {
+			return fmt.Errorf(`failed to install plugin in "%s": %w: failed to restore the previous version: %v`, installationDir, err, restoreErr)
+		}
+		return fmt.Errorf(`failed to install plugin in "%s": %w`, installationDir, err)
+	}
+
+	return nil
+}
+
+// isNewerVersion returns whether the latest version is newer than the installed version. If either is not a semantic
+// version, any other version is considered newer.
+func isNewerVersion(latest, installed string) bool {
+	latestVersion, err := version.NewVersion(latest)
+	if err != nil {
+		return latest != installed
+	}
+	installedVersion, err := version.NewVersion(installed)
+	if err != nil {
+		return latest != installed
+	}
+	return latestVersion.GreaterThan(installedVersion)
+}
@@ -0,0 +1,178 @@
+package connect
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/dghubble/sling"
+	"github.com/spf13/cobra"
+
+	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
+
+	pauth "github.com/confluentinc/cli/v3/pkg/auth"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+// connectRestClient manages the connectors of a self-managed Kafka Connect cluster through the REST API of one of its workers.
+type connectRestClient struct {
+	sling *sling.Sling
+}
+
+type connectRestError struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// newConnectRestClient creates a client for the worker passed to "--url", which authenticates with the MDS token of the current context.
+func (c *clusterCommand) newConnectRestClient(cmd *cobra.Command) (*connectRestClient, error) {
+	workerUrl, err := getConnectRestUrl(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	caCertPath, err := cmd.Flags().GetString("ca-cert-path")
+	if err != nil {
+		return nil, err
+	}
+	if caCertPath == "" {
+		caCertPath = pauth.GetEnvWithFallback(pauth.ConfluentPlatformCACertPath, pauth.DeprecatedConfluentPlatformCACertPath)
+	}
+
+	clientCertPath, err := cmd.Flags().GetString("client-cert-path")
+	if err != nil {
+		return nil, err
+	}
+
+	clientKeyPath, err := cmd.Flags().GetString("client-key-path")
+	if err != nil {
+		return nil, err
+	}
+
+	if (clientCertPath == "") != (clientKeyPath == "") {
+		return nil, fmt.Errorf(errors.NeedClientCertAndKeyPathsErrorMsg)
+	}
+
+	httpClient := utils.DefaultClient()
+	if caCertPath != "" || clientCertPath != "" {
+		httpClient, err = utils.CustomCAAndClientCertClient(caCertPath, clientCertPath, clientKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.Contains(workerUrl, "://") {
+			workerUrl = "https://" + workerUrl
+		}
+	}
+	if !strings.Contains(workerUrl, "://") {
+		workerUrl = "http://" + workerUrl
+	}
+
+	client := sling.New().Client(httpClient).Base(strings.TrimSuffix(workerUrl, "/") + "/")
+
+	noAuthentication, err := cmd.Flags().GetBool("no-authentication")
+	if err != nil {
+		return nil, err
+	}
+	if !noAuthentication {
+		client = client.Set("Authorization", "Bearer "+c.Context.GetAuthToken())
+	}
+
+	return &connectRestClient{sling: client}, nil
+}
+
+// getConnectRestUrl fetches the URL of a Kafka Connect worker from the --url flag or from the CONFLUENT_CONNECT_URL environment variable.
+func getConnectRestUrl(cmd *cobra.Command) (string, error) {
+	if workerUrl, _ := cmd.Flags().GetString("url"); workerUrl != "" {
+		return workerUrl, nil
+	}
+	if workerUrl := os.Getenv("CONFLUENT_CONNECT_URL"); workerUrl != "" {
+		return workerUrl, nil
+	}
+	return "", errors.NewErrorWithSuggestions(errors.ConnectRestUrlNotFoundErrorMsg, errors.ConnectRestUrlNotFoundSuggestions)
+}
+
+// The responses of the REST API of a worker have the same format as the connector info and status of Confluent Cloud.
+func (c *connectRestClient) getConnector(name string) (*connectv1.ConnectV1ConnectorExpansionInfo, error) {
+	info := new(connectv1.ConnectV1ConnectorExpansionInfo)
+	err := c.do(c.sling.New().Get(connectorPath(name)), info)
+	return info, err
+}
+
+func (c *connectRestClient) getConnectorStatus(name string) (*connectv1.ConnectV1ConnectorExpansionStatus, error) {
+	status := new(connectv1.ConnectV1ConnectorExpansionStatus)
+	err := c.do(c.sling.New().Get(connectorPath(name, "status")), status)
+	return status, err
+}
+
+// getConnectorExpansion combines the info and status of a connector, which is identified by its name.
+func (c *connectRestClient) getConnectorExpansion(name string) (*connectv1.ConnectV1ConnectorExpansion, error) {
+	info, err := c.getConnector(name)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := c.getConnectorStatus(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &connectv1.ConnectV1ConnectorExpansion{
+		Id:     &connectv1.ConnectV1ConnectorExpansionId{Id: connectv1.PtrString(name)},
+		Info:   info,
+		Status: status,
+	}, nil
+}
+
+func (c *connectRestClient) createConnector(name string, config map[string]string) (*connectv1.ConnectV1ConnectorExpansionInfo, error) {
+	info := new(connectv1.ConnectV1ConnectorExpansionInfo)
+	body := map[string]any{"name": name, "config": config}
+	err := c.do(c.sling.New().Post("connectors").BodyJSON(body), info)
+	return info, err
+}
+
+func (c *connectRestClient) updateConnectorConfig(name string, config map[string]string) error {
+	return c.do(c.sling.New().Put(connectorPath(name, "config")).BodyJSON(config), nil)
+}
+
+func (c *connectRestClient) deleteConnector(name string) error {
+	return c.do(c.sling.New().Delete(connectorPath(name)), nil)
+}
+
+func (c *connectRestClient) pauseConnector(name string) error {
+	return c.do(c.sling.New().Put(connectorPath(name, "pause")), nil)
+}
+
+func (c *connectRestClient) resumeConnector(name string) error {
+	return c.do(c.sling.New().Put(connectorPath(name, "resume")), nil)
+}
+
+// do sends a request and decodes a successful response into v, which may be nil for responses without a body.
+func (c *connectRestClient) do(s *sling.Sling, v any) error {
+	req, err := s.Request()
+	if err != nil {
+		return err
+	}
+
+	failure := new(connectRestError)
+	res, err := s.Receive(v, failure)
+	if err != nil {
+		return fmt.Errorf(errors.ConnectRestErrorMsg, req.Method, req.URL, err)
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		message := failure.Message
+		if message == "" {
+			message = res.Status
+		}
+		return fmt.Errorf(errors.ConnectRestErrorMsg, req.Method, req.URL, message)
+	}
+
+	return nil
+}
+
+func connectorPath(name string, segments ...string) string {
+	return strings.Join(append([]string{"connectors", url.PathEscape(name)}, segments...), "/")
+}
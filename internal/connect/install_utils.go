@@ -1,10 +1,13 @@
 package connect
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -12,6 +15,7 @@ import (
 
 	"github.com/confluentinc/properties"
 
+	"github.com/confluentinc/cli/v3/pkg/cpstructs"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/exec"
 	"github.com/confluentinc/cli/v3/pkg/form"
@@ -69,6 +73,7 @@ func getConfluentPlatformInstallation(cmd *cobra.Command, prompt form.Prompt, fo
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(listOut))
 	for i, installation := range installations {
 		list.Add(&listOut{
 			Number:      strconv.Itoa(i + 1),
@@ -219,16 +224,22 @@ func compactDuplicateInstallations(installations []platformInstallation) []platf
 	return uniqueInstallations
 }
 
-func choosePluginDir(installation *platformInstallation, prompt form.Prompt, force bool) (string, error) {
-	var defaultPluginDir string
+func getDefaultPluginDir(installation *platformInstallation) (string, error) {
 	switch installation.Location.Type {
 	case "ARCHIVE":
-		defaultPluginDir = filepath.Join(installation.Location.Path, "share/confluent-hub-components")
+		return filepath.Join(installation.Location.Path, "share/confluent-hub-components"), nil
 	case "PACKAGE":
-		defaultPluginDir = "/usr/share/confluent-hub-components"
+		return "/usr/share/confluent-hub-components", nil
 	default:
 		return "", fmt.Errorf(unexpectedInstallationErrorMsg, installation.Location.Type)
 	}
+}
+
+func choosePluginDir(installation *platformInstallation, prompt form.Prompt, force bool) (string, error) {
+	defaultPluginDir, err := getDefaultPluginDir(installation)
+	if err != nil {
+		return "", err
+	}
 
 	if force {
 		output.Printf(false, "Using \"%s\" as the plugin installation directory.\n\n", defaultPluginDir)
@@ -257,7 +268,7 @@ func choosePluginDir(installation *platformInstallation, prompt form.Prompt, for
 	}
 
 	inputDir := f.Responses["directory"].(string)
-	inputDir, err := filepath.Abs(inputDir)
+	inputDir, err = filepath.Abs(inputDir)
 	if err != nil {
 		return "", err
 	}
@@ -383,6 +394,7 @@ func chooseWorkerConfigs(cmd *cobra.Command, installation *platformInstallation,
 
 	output.Println(false, "Detected the following worker configuration files:")
 	list := output.NewList(cmd)
+	list.Columns(new(listOut))
 	for i, workerConfig := range workerConfigs {
 		list.Add(&listOut{
 			Number:      strconv.Itoa(i + 1),
@@ -431,9 +443,9 @@ func chooseWorkerConfigs(cmd *cobra.Command, installation *platformInstallation,
 	return result, nil
 }
 
-func updateWorkerConfig(pluginDir, workerConfigPath string, dryRun bool) error {
-	pluginPathProperty := "plugin.path"
+const pluginPathProperty = "plugin.path"
 
+func updateWorkerConfig(pluginDir, workerConfigPath string, dryRun bool) error {
 	workerConfig, err := properties.LoadFile(workerConfigPath, properties.UTF8)
 	if err != nil {
 		return fmt.Errorf(`failed to parse worker configuration file "%s": %w`, workerConfigPath, err)
@@ -447,6 +459,11 @@ func updateWorkerConfig(pluginDir, workerConfigPath string, dryRun bool) error {
 		}
 	}
 	newPluginPath := strings.Join(append(pluginPathElements, pluginDir), ", ")
+	return setPluginPath(workerConfig, workerConfigPath, newPluginPath, dryRun)
+}
+
+// setPluginPath sets the plugin path of a worker configuration, and unless dryRun is set, writes it to its file.
+func setPluginPath(workerConfig *properties.Properties, workerConfigPath, newPluginPath string, dryRun bool) error {
 	if _, _, err := workerConfig.Set(pluginPathProperty, newPluginPath); err != nil {
 		return fmt.Errorf(`failed to update %s property to "%s" for worker configuration "%s": %w`, pluginPathProperty, newPluginPath, workerConfigPath, err)
 	}
@@ -468,3 +485,209 @@ func updateWorkerConfig(pluginDir, workerConfigPath string, dryRun bool) error {
 	}
 	return nil
 }
+
+// removeFromPluginPath removes the given directories, and any directories inside them, from the plugin path of a worker
+// configuration. It returns whether the plugin path was changed.
+func removeFromPluginPath(workerConfigPath string, dirs []string, dryRun bool) (bool, error) {
+	workerConfig, err := properties.LoadFile(workerConfigPath, properties.UTF8)
+	if err != nil {
+		return false, fmt.Errorf(`failed to parse worker configuration file "%s": %w`, workerConfigPath, err)
+	}
+
+	var pluginPathElements []string
+	for _, pluginPathElement := range getPluginPath(workerConfig) {
+		if !slices.ContainsFunc(dirs, func(dir string) bool { return isSameOrInsideDir(pluginPathElement, dir) }) {
+			pluginPathElements = append(pluginPathElements, pluginPathElement)
+		}
+	}
+	if len(pluginPathElements) == len(getPluginPath(workerConfig)) {
+		return false, nil
+	}
+
+	return true, setPluginPath(workerConfig, workerConfigPath, strings.Join(pluginPathElements, ", "), dryRun)
+}
+
+func getPluginPath(workerConfig *properties.Properties) []string {
+	pluginPath := strings.TrimSpace(workerConfig.GetString(pluginPathProperty, ""))
+	if pluginPath == "" {
+		return nil
+	}
+	return regexp.MustCompile(" *, *").Split(pluginPath, -1)
+}
+
+func isSameOrInsideDir(path, dir string) bool {
+	rel, err := filepath.Rel(absPath(dir), absPath(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
+
+// installedPlugin is a plugin installed by Confluent Hub, which is a directory with a manifest inside a plugin directory.
+type installedPlugin struct {
+	Manifest  *cpstructs.Manifest
+	Directory string
+	PluginDir string
+}
+
+func (p *installedPlugin) id() string {
+	return fmt.Sprintf("%s/%s", p.Manifest.Owner.Username, p.Manifest.Name)
+}
+
+// getPluginDirs returns the plugin directory passed to "--plugin-directory" or, if it is not set, the default plugin
+// directory of each Confluent Platform installation along with the plugin path of its standard worker configurations.
+func getPluginDirs(cmd *cobra.Command) ([]string, error) {
+	pluginDir, err := getPluginDirFromFlag(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if pluginDir != "" {
+		return []string{pluginDir}, nil
+	}
+
+	installations, err := getPlatformInstallations(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	var pluginDirs []string
+	for _, installation := range installations {
+		defaultPluginDir, err := getDefaultPluginDir(&installation)
+		if err != nil {
+			return nil, err
+		}
+		pluginDirs = append(pluginDirs, defaultPluginDir)
+
+		workerConfigs, err := getStandardWorkerConfigs(&installation)
+		if err != nil {
+			return nil, err
+		}
+		for _, workerConfigPath := range workerConfigs {
+			workerConfig, err := properties.LoadFile(workerConfigPath, properties.UTF8)
+			if err != nil {
+				return nil, fmt.Errorf(`failed to parse worker configuration file "%s": %w`, workerConfigPath, err)
+			}
+			pluginDirs = append(pluginDirs, getPluginPath(workerConfig)...)
+		}
+	}
+
+	var uniquePluginDirs []string
+	set := types.NewSet[string]()
+	for _, pluginDir := range pluginDirs {
+		if !set.Contains(absPath(pluginDir)) && utils.DoesPathExist(pluginDir) {
+			set.Add(absPath(pluginDir))
+			uniquePluginDirs = append(uniquePluginDirs, pluginDir)
+		}
+	}
+
+	return uniquePluginDirs, nil
+}
+
+// getPlatformInstallations returns the installation passed to "--confluent-platform", or else every detected installation.
+func getPlatformInstallations(cmd *cobra.Command) ([]platformInstallation, error) {
+	if cmd.Flags().Changed("confluent-platform") {
+		installation, err := getPlatformInstallationFromFlag(cmd)
+		if err != nil {
+			return nil, err
+		}
+		return []platformInstallation{*installation}, nil
+	}
+
+	return findInstallationDirectories()
+}
+
+// getStandardWorkerConfigs returns the standard worker configurations of an installation which exist.
+func getStandardWorkerConfigs(installation *platformInstallation) ([]string, error) {
+	workerConfigs, err := standardWorkerConfigLocations(installation)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, workerConfig := range workerConfigs {
+		if utils.DoesPathExist(workerConfig.Path) {
+			paths = append(paths, workerConfig.Path)
+		}
+	}
+	return paths, nil
+}
+
+// findInstalledPlugins returns the plugins installed in the given plugin directories, sorted by ID.
+func findInstalledPlugins(pluginDirs []string) ([]installedPlugin, error) {
+	var plugins []installedPlugin
+	for _, pluginDir := range pluginDirs {
+		entries, err := os.ReadDir(pluginDir)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to read plugin directory "%s": %w`, pluginDir, err)
+		}
+
+		for _, entry := range entries {
+			dir := filepath.Join(pluginDir, entry.Name())
+			manifestPath := filepath.Join(dir, "manifest.json")
+			if !entry.IsDir() || !utils.DoesPathExist(manifestPath) {
+				continue
+			}
+
+			manifest, err := readManifest(manifestPath)
+			if err != nil {
+				return nil, err
+			}
+
+			plugins = append(plugins, installedPlugin{
+				Manifest:  manifest,
+				Directory: dir,
+				PluginDir: pluginDir,
+			})
+		}
+	}
+
+	sort.SliceStable(plugins, func(i, j int) bool {
+		return plugins[i].id() < plugins[j].id()
+	})
+
+	return plugins, nil
+}
+
+func readManifest(path string) (*cpstructs.Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read manifest file "%s": %w`, path, err)
+	}
+
+	manifest := new(cpstructs.Manifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf(`failed to parse manifest file "%s": %w`, path, err)
+	}
+
+	return manifest, nil
+}
+
+// filterInstalledPlugins returns the installed plugins with the given IDs, formatted as "<owner>/<name>", or every
+// installed plugin if there are no IDs.
+func filterInstalledPlugins(plugins []installedPlugin, ids []string) ([]installedPlugin, error) {
+	if len(ids) == 0 {
+		return plugins, nil
+	}
+
+	var filtered []installedPlugin
+	for _, id := range ids {
+		found := false
+		for _, plugin := range plugins {
+			if plugin.id() == id {
+				filtered = append(filtered, plugin)
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.NewErrorWithSuggestions(
+				fmt.Sprintf(`plugin "%s" is not installed`, id),
+				"List the installed plugins with `confluent connect plugin list --installed`.",
+			)
+		}
+	}
+	return filtered, nil
+}
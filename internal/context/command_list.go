@@ -29,6 +29,7 @@ func (c *command) newListCommand() *cobra.Command {
 
 func (c *command) list(cmd *cobra.Command, _ []string) error {
 	list := output.NewList(cmd)
+	list.Columns(new(listOut))
 	for _, context := range c.Config.Contexts {
 		list.Add(&listOut{
 			IsCurrent:  context.Name == c.Config.CurrentContext,
@@ -28,6 +28,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, environment := range environments {
 		list.Add(&out{
 			IsCurrent: environment.GetId() == c.Context.GetCurrentEnvironment(),
@@ -39,6 +39,7 @@ func (c *command) computePoolList(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(computePoolOut))
 	for _, computePool := range computePools {
 		list.Add(&computePoolOut{
 			IsCurrent:  computePool.GetId() == c.Context.GetCurrentFlinkComputePool(),
@@ -42,6 +42,7 @@ func (c *command) statementExceptionList(cmd *cobra.Command, args []string) erro
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(exceptionOut))
 	for _, exception := range exceptions {
 		list.Add(&exceptionOut{
 			Name:       exception.GetName(),
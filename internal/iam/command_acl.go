@@ -100,6 +100,7 @@ func convertToAclFilterRequest(request *mdsv1.CreateAclRequest) mdsv1.AclFilterR
 
 func printAcls(cmd *cobra.Command, kafkaClusterId string, aclBindings []mdsv1.AclBinding) error {
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, binding := range aclBindings {
 		list.Add(&out{
 			KafkaClusterId: kafkaClusterId,
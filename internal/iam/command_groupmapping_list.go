@@ -28,6 +28,7 @@ func (c *groupMappingCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(groupMappingOut))
 	for _, groupMapping := range groupMappings {
 		list.Add(&groupMappingOut{
 			Id:          groupMapping.GetId(),
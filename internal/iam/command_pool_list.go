@@ -36,6 +36,7 @@ func (c *poolCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(poolOut))
 	for _, pool := range identityPools {
 		list.Add(&poolOut{
 			Id:            pool.GetId(),
@@ -28,6 +28,7 @@ func (c *identityProviderCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(identityProviderOut))
 	for _, provider := range identityProviders {
 		list.Add(&identityProviderOut{
 			Id:          provider.GetId(),
@@ -205,6 +205,7 @@ func (c *roleBindingCommand) listPrincipalResources(cmd *cobra.Command, options
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(roleBindingOut))
 	for principalName, rolesResourcePatterns := range principalsRolesResourcePatterns {
 		for roleName, resourcePatterns := range rolesResourcePatterns {
 			if role == "*" || roleName == role {
@@ -253,6 +254,7 @@ func (c *roleBindingCommand) listPrincipalResourcesV1(cmd *cobra.Command, mdsSco
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(roleBindingOut))
 	for _, roleName := range roleNames {
 		resourcePatterns, _, err := c.MDSClient.RBACRoleBindingCRUDApi.GetRoleResourcesForPrincipal(c.createContext(), principal, roleName, *mdsScope)
 		if err != nil {
@@ -310,6 +312,7 @@ func (c *roleBindingCommand) confluentListRolePrincipals(cmd *cobra.Command, opt
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(roleBindingOut))
 	for _, principal := range principals {
 		list.Add(&roleBindingOut{Principal: principal})
 	}
@@ -328,6 +331,7 @@ func (c *roleBindingCommand) ccloudList(cmd *cobra.Command, listRoleBinding *mds
 
 func (c *roleBindingCommand) listMyRoleBindings(cmd *cobra.Command, listRoleBinding *mdsv2.IamV2RoleBinding) error {
 	list := output.NewList(cmd)
+	list.Columns(new(roleBindingOut))
 
 	currentUser, err := cmd.Flags().GetBool("current-user")
 	if err != nil {
@@ -28,6 +28,7 @@ func (c *serviceAccountCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(serviceAccountOut))
 	for _, serviceAccount := range serviceAccounts {
 		list.Add(&serviceAccountOut{
 			ResourceId:  serviceAccount.GetId(),
@@ -40,6 +40,7 @@ func (c invitationCommand) listInvitations(cmd *cobra.Command, _ []string) error
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(invitationOut))
 	for _, invitation := range invitations {
 		var name string
 		if user, err := c.V2Client.GetIamUserById(invitation.User.GetId()); err == nil {
@@ -27,6 +27,7 @@ func (c *userCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(userOut))
 	for _, user := range users {
 		list.Add(&userOut{
 			Id:                   user.GetId(),
@@ -35,6 +35,7 @@ func (c *clusterCommand) configurationList(cmd *cobra.Command, _ []string) error
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(configurationOut))
 	for _, config := range configs {
 		list.Add(&configurationOut{
 			Name:     config.GetName(),
@@ -30,6 +30,7 @@ func (c *consumerCommand) newGroupCommand(cfg *config.Config) *cobra.Command {
 		cmd.AddCommand(c.newGroupListCommandOnPrem())
 	}
 	cmd.AddCommand(c.newLagCommand(cfg))
+	cmd.AddCommand(c.newGroupOffsetCommand(cfg))
 
 	return cmd
 }
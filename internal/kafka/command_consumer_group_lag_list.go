@@ -43,6 +43,7 @@ func (c *consumerCommand) groupLagList(cmd *cobra.Command, args []string) error
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(lagOut))
 	for _, consumerLag := range consumerLags {
 		list.Add(&lagOut{
 			ClusterId:       consumerLag.GetClusterId(),
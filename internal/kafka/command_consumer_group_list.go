@@ -35,6 +35,7 @@ func (c *consumerCommand) groupList(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(consumerGroupOut))
 	for _, group := range groups {
 		list.Add(&consumerGroupOut{
 			ClusterId:         group.GetClusterId(),
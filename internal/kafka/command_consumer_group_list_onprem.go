@@ -35,6 +35,7 @@ func (c *consumerCommand) groupListOnPrem(cmd *cobra.Command, _ []string) error
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(consumerGroupOut))
 	for _, group := range groups.Data {
 		list.Add(&consumerGroupOut{
 			ClusterId:         group.ClusterId,
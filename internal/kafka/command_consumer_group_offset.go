@@ -0,0 +1,434 @@
+package kafka
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+const offsetResetTimeoutMs = 10000
+
+// noCommittedOffset is displayed as the current offset of partitions without a committed offset.
+const noCommittedOffset = -1
+
+type offsetResetOut struct {
+	Topic         string `human:"Topic" serialized:"topic"`
+	Partition     int32  `human:"Partition" serialized:"partition"`
+	CurrentOffset int64  `human:"Current Offset" serialized:"current_offset"`
+	NewOffset     int64  `human:"New Offset" serialized:"new_offset"`
+}
+
+// offsetResetSpec is the target of an offset reset. Exactly one of the strategies is set.
+type offsetResetSpec struct {
+	toEarliest bool
+	toLatest   bool
+	toOffset   *int64
+	toDatetime *time.Time
+	shiftBy    *int64
+}
+
+// offsetResetClient is the subset of a Kafka consumer used to reset offsets.
+type offsetResetClient interface {
+	GetMetadata(topic *string, allTopics bool, timeoutMs int) (*ckafka.Metadata, error)
+	Committed(partitions []ckafka.TopicPartition, timeoutMs int) ([]ckafka.TopicPartition, error)
+	QueryWatermarkOffsets(topic string, partition int32, timeoutMs int) (int64, int64, error)
+	OffsetsForTimes(times []ckafka.TopicPartition, timeoutMs int) ([]ckafka.TopicPartition, error)
+}
+
+func (c *consumerCommand) newGroupOffsetCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offset",
+		Short: "Manage consumer group offsets.",
+	}
+
+	if cfg.IsCloudLogin() {
+		cmd.AddCommand(c.newGroupOffsetExportCommand())
+		cmd.AddCommand(c.newGroupOffsetImportCommand())
+		cmd.AddCommand(c.newGroupOffsetResetCommand())
+	} else {
+		cmd.AddCommand(c.newGroupOffsetExportCommandOnPrem())
+		cmd.AddCommand(c.newGroupOffsetImportCommandOnPrem())
+		cmd.AddCommand(c.newGroupOffsetResetCommandOnPrem())
+	}
+
+	return cmd
+}
+
+func addOffsetResetFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("to-earliest", false, "Reset offsets to the earliest offset of each partition.")
+	cmd.Flags().Bool("to-latest", false, "Reset offsets to the latest offset of each partition.")
+	cmd.Flags().Int64("to-offset", 0, "Reset offsets to this offset, bounded by the earliest and latest offsets of each partition.")
+	cmd.Flags().String("to-datetime", "", `Reset offsets to the earliest offset whose timestamp is at or after this time, formatted as RFC 3339 (for example, "2024-01-02T15:04:05Z").`)
+	cmd.Flags().Int64("shift-by", 0, "Shift the committed offsets by this number, which may be negative, bounded by the earliest and latest offsets of each partition.")
+	cmd.Flags().StringArray("topic", nil, `Reset the offsets of this topic, or of some of its partitions when formatted as "topic:0,1,2". May be passed multiple times. Defaults to every topic with an offset committed by the group.`)
+
+	cmd.MarkFlagsOneRequired("to-earliest", "to-latest", "to-offset", "to-datetime", "shift-by")
+	cmd.MarkFlagsMutuallyExclusive("to-earliest", "to-latest", "to-offset", "to-datetime", "shift-by")
+}
+
+func addOffsetImportFlags(cmd *cobra.Command) {
+	cmd.Flags().String("file", "", "The file to read the imported offsets from.")
+	cmd.Flags().String("group", "", "The consumer group to import the offsets to. Defaults to the group the offsets were exported from.")
+	cmd.Flags().Bool("translate-by-timestamp", false, "Translate each offset to the first offset of the partition whose timestamp is at or after the exported timestamp.")
+	pcmd.AddDryRunFlag(cmd)
+}
+
+func readGroupOffsetImportFlags(cmd *cobra.Command) (*GroupOffsetArchive, string, error) {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return nil, "", err
+	}
+
+	archive, err := ReadGroupOffsetArchive(file)
+	if err != nil {
+		return nil, "", err
+	}
+
+	group, err := cmd.Flags().GetString("group")
+	if err != nil {
+		return nil, "", err
+	}
+	if group == "" {
+		group = archive.ConsumerGroupId
+	}
+
+	return archive, group, nil
+}
+
+func getOffsetResetSpec(cmd *cobra.Command) (*offsetResetSpec, error) {
+	spec := new(offsetResetSpec)
+
+	var err error
+	if spec.toEarliest, err = cmd.Flags().GetBool("to-earliest"); err != nil {
+		return nil, err
+	}
+	if spec.toLatest, err = cmd.Flags().GetBool("to-latest"); err != nil {
+		return nil, err
+	}
+
+	if cmd.Flags().Changed("to-offset") {
+		offset, err := cmd.Flags().GetInt64("to-offset")
+		if err != nil {
+			return nil, err
+		}
+		if offset < 0 {
+			return nil, fmt.Errorf(`"--to-offset" must not be negative`)
+		}
+		spec.toOffset = &offset
+	}
+
+	if cmd.Flags().Changed("to-datetime") {
+		datetime, err := cmd.Flags().GetString("to-datetime")
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse(time.RFC3339, datetime)
+		if err != nil {
+			return nil, fmt.Errorf(`invalid value "%s" for "--to-datetime": must be formatted as RFC 3339, for example "2024-01-02T15:04:05Z"`, datetime)
+		}
+		spec.toDatetime = &t
+	}
+
+	if cmd.Flags().Changed("shift-by") {
+		shift, err := cmd.Flags().GetInt64("shift-by")
+		if err != nil {
+			return nil, err
+		}
+		spec.shiftBy = &shift
+	}
+
+	return spec, nil
+}
+
+// parseTopicPartitions parses values such as "orders" and "orders:0,1,2" into the partitions of each topic. A topic
+// without partitions maps to nil, meaning all of its partitions.
+func parseTopicPartitions(values []string) (map[string][]int32, error) {
+	topics := make(map[string][]int32)
+	for _, value := range values {
+		topic, partitionsStr, hasPartitions := strings.Cut(value, ":")
+		if topic == "" {
+			return nil, fmt.Errorf(`invalid value "%s" for "--topic": missing topic name`, value)
+		}
+
+		if !hasPartitions {
+			topics[topic] = nil
+			continue
+		}
+
+		var partitions []int32
+		for _, partitionStr := range strings.Split(partitionsStr, ",") {
+			partition, err := strconv.ParseInt(strings.TrimSpace(partitionStr), 10, 32)
+			if err != nil || partition < 0 {
+				return nil, fmt.Errorf(`invalid value "%s" for "--topic": partitions must be a comma-separated list of non-negative integers`, value)
+			}
+			partitions = append(partitions, int32(partition))
+		}
+		topics[topic] = append(topics[topic], partitions...)
+	}
+	return topics, nil
+}
+
+// getOffsetResetPartitions returns the partitions whose offsets are reset, with their committed offsets. Without any
+// topics, these are the partitions of every topic for which the group has committed an offset.
+func getOffsetResetPartitions(client offsetResetClient, topics map[string][]int32) ([]ckafka.TopicPartition, error) {
+	metadata, err := client.GetMetadata(nil, true, offsetResetTimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+
+	var partitions []ckafka.TopicPartition
+	if len(topics) == 0 {
+		for name, topic := range metadata.Topics {
+			if strings.HasPrefix(name, "_") {
+				continue
+			}
+			name := name
+			for _, partition := range topic.Partitions {
+				partitions = append(partitions, ckafka.TopicPartition{Topic: &name, Partition: partition.ID})
+			}
+		}
+	} else {
+		for name, requested := range topics {
+			topic, ok := metadata.Topics[name]
+			if !ok || topic.Error.Code() == ckafka.ErrUnknownTopicOrPart {
+				return nil, fmt.Errorf(`topic "%s" does not exist`, name)
+			}
+
+			var ids []int32
+			for _, partition := range topic.Partitions {
+				ids = append(ids, partition.ID)
+			}
+
+			if requested == nil {
+				requested = ids
+			}
+			for _, partition := range requested {
+				if !slices.Contains(ids, partition) {
+					return nil, fmt.Errorf(`topic "%s" does not have partition %d`, name, partition)
+				}
+				partitions = append(partitions, ckafka.TopicPartition{Topic: &topic.Topic, Partition: partition})
+			}
+		}
+	}
+
+	if len(partitions) == 0 {
+		return nil, nil
+	}
+
+	committed, err := client.Committed(partitions, offsetResetTimeoutMs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(topics) == 0 {
+		committed = slices.DeleteFunc(committed, func(partition ckafka.TopicPartition) bool { return partition.Offset < 0 })
+	}
+
+	sort.Slice(committed, func(i, j int) bool {
+		if *committed[i].Topic != *committed[j].Topic {
+			return *committed[i].Topic < *committed[j].Topic
+		}
+		return committed[i].Partition < committed[j].Partition
+	})
+
+	return committed, nil
+}
+
+// planOffsetReset returns the new offset of each partition.
+func planOffsetReset(client offsetResetClient, partitions []ckafka.TopicPartition, spec *offsetResetSpec) ([]ckafka.TopicPartition, error) {
+	timestampOffsets := make(map[string]int64)
+	if spec.toDatetime != nil {
+		times := make([]ckafka.TopicPartition, len(partitions))
+		for i, partition := range partitions {
+			times[i] = ckafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(spec.toDatetime.UnixMilli())}
+		}
+
+		offsets, err := client.OffsetsForTimes(times, offsetResetTimeoutMs)
+		if err != nil {
+			return nil, err
+		}
+		for _, offset := range offsets {
+			timestampOffsets[topicPartitionKey(offset)] = int64(offset.Offset)
+		}
+	}
+
+	newOffsets := make([]ckafka.TopicPartition, len(partitions))
+	for i, partition := range partitions {
+		low, high, err := client.QueryWatermarkOffsets(*partition.Topic, partition.Partition, offsetResetTimeoutMs)
+		if err != nil {
+			return nil, err
+		}
+
+		timestampOffset := timestampOffsets[topicPartitionKey(partition)]
+
+		offset, err := computeNewOffset(spec, int64(partition.Offset), low, high, timestampOffset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reset the offset of partition %d of topic \"%s\": %w", partition.Partition, *partition.Topic, err)
+		}
+		newOffsets[i] = ckafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(offset)}
+	}
+
+	return newOffsets, nil
+}
+
+func topicPartitionKey(partition ckafka.TopicPartition) string {
+	return fmt.Sprintf("%s:%d", *partition.Topic, partition.Partition)
+}
+
+// computeNewOffset returns the new offset of a partition with the given committed offset and watermarks. The timestamp
+// offset is the result of looking up "--to-datetime", which is negative when no message is that recent.
+func computeNewOffset(spec *offsetResetSpec, current, low, high, timestampOffset int64) (int64, error) {
+	switch {
+	case spec.toEarliest:
+		return low, nil
+	case spec.toLatest:
+		return high, nil
+	case spec.toOffset != nil:
+		return clampOffset(*spec.toOffset, low, high), nil
+	case spec.toDatetime != nil:
+		if timestampOffset < 0 {
+			return high, nil
+		}
+		return clampOffset(timestampOffset, low, high), nil
+	case spec.shiftBy != nil:
+		if current < 0 {
+			return 0, fmt.Errorf(`the group has no committed offset to shift`)
+		}
+		return clampOffset(current+*spec.shiftBy, low, high), nil
+	default:
+		return 0, fmt.Errorf("no offset reset strategy")
+	}
+}
+
+func clampOffset(offset, low, high int64) int64 {
+	return max(low, min(offset, high))
+}
+
+// resetOffsets prints the offset reset plan of a group and, unless "--dry-run" is set, commits the new offsets. The
+// group must be inactive, since the offsets of a group are only committed by its members while it has any.
+func resetOffsets(cmd *cobra.Command, consumer *ckafka.Consumer, group string, enableColor bool) error {
+	spec, err := getOffsetResetSpec(cmd)
+	if err != nil {
+		return err
+	}
+
+	topicValues, err := cmd.Flags().GetStringArray("topic")
+	if err != nil {
+		return err
+	}
+	topics, err := parseTopicPartitions(topicValues)
+	if err != nil {
+		return err
+	}
+
+	partitions, err := getOffsetResetPartitions(consumer, topics)
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return fmt.Errorf(`consumer group "%s" has no committed offsets: specify the topics to reset with "--topic"`, group)
+	}
+
+	newOffsets, err := planOffsetReset(consumer, partitions, spec)
+	if err != nil {
+		return err
+	}
+
+	committed, err := commitGroupOffsets(cmd, consumer, group, partitions, newOffsets)
+	if err != nil {
+		return err
+	}
+
+	if committed && output.GetFormat(cmd) == output.Human {
+		output.Printf(enableColor, "Reset the offsets of %d partition(s) for consumer group \"%s\".\n", len(newOffsets), group)
+	}
+	return nil
+}
+
+// importOffsets prints the offsets of a group read from an archive and, unless "--dry-run" is set, commits them.
+func importOffsets(cmd *cobra.Command, consumer *ckafka.Consumer, archive *GroupOffsetArchive, group string, enableColor bool) error {
+	translate, err := cmd.Flags().GetBool("translate-by-timestamp")
+	if err != nil {
+		return err
+	}
+
+	partitions, newOffsets, err := planOffsetImport(consumer, archive, translate)
+	if err != nil {
+		return err
+	}
+	if len(partitions) == 0 {
+		return fmt.Errorf(`the file does not contain any offsets of consumer group "%s"`, archive.ConsumerGroupId)
+	}
+
+	committed, err := commitGroupOffsets(cmd, consumer, group, partitions, newOffsets)
+	if err != nil {
+		return err
+	}
+
+	if committed && output.GetFormat(cmd) == output.Human {
+		output.Printf(enableColor, "Imported the offsets of %d partition(s) for consumer group \"%s\".\n", len(newOffsets), group)
+	}
+	return nil
+}
+
+// commitGroupOffsets prints the current and new offsets of each partition and, unless "--dry-run" is set, commits the
+// new offsets for the group. It returns whether the offsets were committed.
+func commitGroupOffsets(cmd *cobra.Command, consumer *ckafka.Consumer, group string, partitions, newOffsets []ckafka.TopicPartition) (bool, error) {
+	list := output.NewList(cmd)
+	list.Columns(new(offsetResetOut))
+	for i, partition := range partitions {
+		current := int64(partition.Offset)
+		if current < 0 {
+			current = noCommittedOffset
+		}
+		list.Add(&offsetResetOut{
+			Topic:         *partition.Topic,
+			Partition:     partition.Partition,
+			CurrentOffset: current,
+			NewOffset:     int64(newOffsets[i].Offset),
+		})
+	}
+	list.Sort(false)
+	if err := list.Print(); err != nil {
+		return false, err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return false, err
+	}
+	if dryRun {
+		return false, nil
+	}
+
+	committed, err := consumer.CommitOffsets(newOffsets)
+	if err != nil {
+		return false, fmt.Errorf(`failed to commit the offsets of consumer group "%s": %w`, group, err)
+	}
+	for _, partition := range committed {
+		if partition.Error != nil {
+			return false, fmt.Errorf(`failed to commit the offset of partition %d of topic "%s" for consumer group "%s": %w`, partition.Partition, *partition.Topic, group, partition.Error)
+		}
+	}
+
+	return true, nil
+}
+
+// checkGroupInactive returns an error unless the state of the group, as reported by Kafka REST, is empty or dead.
+func checkGroupInactive(group, state string) error {
+	if state == "EMPTY" || state == "DEAD" {
+		return nil
+	}
+	return fmt.Errorf(`consumer group "%s" is in state "%s": stop its consumers before changing its offsets`, group, state)
+}
@@ -0,0 +1,96 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *consumerCommand) newGroupOffsetExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "export <group>",
+		Short:             "Export the offsets of a Kafka consumer group to a file.",
+		Long:              "Export the committed offsets of a Kafka consumer group to a file which can be loaded with `confluent kafka consumer group offset import`.\n\nAlong with each offset, the timestamp of the next message the group would consume is stored, so that the offsets can be translated by timestamp when importing them to another cluster.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validGroupArgs),
+		RunE:              c.groupOffsetExport,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export the offsets of consumer group "my-group" to "my-group.json".`,
+				Code: "confluent kafka consumer group offset export my-group --file my-group.json",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "The file to write the exported offsets to.")
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+
+	return cmd
+}
+
+func (c *consumerCommand) groupOffsetExport(cmd *cobra.Command, args []string) error {
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return err
+	}
+
+	consumerLags, err := kafkaREST.CloudClient.ListKafkaConsumerLags(args[0])
+	if err != nil {
+		return err
+	}
+
+	archive := NewGroupOffsetArchive(args[0])
+	for _, consumerLag := range consumerLags {
+		archive.addLag(consumerLag.GetTopicName(), consumerLag.GetPartitionId(), consumerLag.GetCurrentOffset(), consumerLag.GetLogEndOffset())
+	}
+
+	cluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	if err := addApiKeyToCluster(cmd, cluster); err != nil {
+		return err
+	}
+
+	group := fmt.Sprintf("confluent_cli_export_%s", uuid.New())
+	consumer, err := newConsumer(group, cluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	defer consumer.Close()
+
+	if err := archive.ReadTimestamps(consumer, offsetTimestampsTimeout); err != nil {
+		return err
+	}
+
+	return c.writeGroupOffsetArchive(cmd, archive)
+}
+
+func (c *consumerCommand) writeGroupOffsetArchive(cmd *cobra.Command, archive *GroupOffsetArchive) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	archive.sort()
+	if err := archive.Write(file); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported the offsets of %d partition(s) of consumer group \"%s\" to \"%s\".\n", len(archive.Offsets), archive.ConsumerGroupId, file)
+	return nil
+}
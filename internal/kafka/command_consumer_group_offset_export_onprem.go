@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+func (c *consumerCommand) newGroupOffsetExportCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <group>",
+		Short: "Export the offsets of a Kafka consumer group to a file.",
+		Long:  "Export the committed offsets of a Kafka consumer group, read with Kafka REST, to a file which can be loaded with `confluent kafka consumer group offset import`.\n\nAlong with each offset, the timestamp of the next message the group would consume is read with a Kafka client connected to \"--bootstrap\" and stored, so that the offsets can be translated by timestamp when importing them to another cluster.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.groupOffsetExportOnPrem,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export the offsets of consumer group "my-group" to "my-group.json".`,
+				Code: "confluent kafka consumer group offset export my-group --file my-group.json --url http://localhost:8082 --bootstrap localhost:9092",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "The file to write the exported offsets to.")
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+	pcmd.AddProtocolFlag(cmd)
+	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
+
+	return cmd
+}
+
+func (c *consumerCommand) groupOffsetExportOnPrem(cmd *cobra.Command, args []string) error {
+	restClient, restContext, clusterId, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	consumerLags, resp, err := restClient.ConsumerGroupV3Api.ListKafkaConsumerLags(restContext, clusterId, args[0])
+	if err != nil {
+		return kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+
+	archive := NewGroupOffsetArchive(args[0])
+	for _, consumerLag := range consumerLags.Data {
+		archive.addLag(consumerLag.TopicName, consumerLag.PartitionId, consumerLag.CurrentOffset, consumerLag.LogEndOffset)
+	}
+
+	consumer, err := newOnPremConsumer(cmd, c.clientID, "", nil)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	defer consumer.Close()
+
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
+		return err
+	}
+
+	if err := archive.ReadTimestamps(consumer, offsetTimestampsTimeout); err != nil {
+		return err
+	}
+
+	return c.writeGroupOffsetArchive(cmd, archive)
+}
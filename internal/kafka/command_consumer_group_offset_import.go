@@ -0,0 +1,85 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+)
+
+func (c *consumerCommand) newGroupOffsetImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import the offsets of a Kafka consumer group from a file.",
+		Long:  "Import the offsets of an inactive Kafka consumer group from a file written by `confluent kafka consumer group offset export`. Kafka REST does not support altering consumer group offsets, so the offsets are committed with a Kafka client, which requires an API key for the cluster.\n\nWith `--translate-by-timestamp`, each partition is set to the first offset whose timestamp is at or after that of the next message the group would have consumed when exported, rather than to the exported offset. This keeps the position of the group when the topics were copied to this cluster, since their offsets usually differ.",
+		Args:  cobra.NoArgs,
+		RunE:  c.groupOffsetImport,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview importing the offsets in "my-group.json" to consumer group "my-group".`,
+				Code: "confluent kafka consumer group offset import --file my-group.json --dry-run",
+			},
+			examples.Example{
+				Text: `Import the offsets in "my-group.json", exported from another cluster, to consumer group "my-new-group".`,
+				Code: "confluent kafka consumer group offset import --file my-group.json --group my-new-group --translate-by-timestamp",
+			},
+		),
+	}
+
+	addOffsetImportFlags(cmd)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+
+	return cmd
+}
+
+func (c *consumerCommand) groupOffsetImport(cmd *cobra.Command, _ []string) error {
+	archive, group, err := readGroupOffsetImportFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return err
+	}
+
+	groups, err := kafkaREST.CloudClient.ListKafkaConsumerGroups()
+	if err != nil {
+		return err
+	}
+	for _, data := range groups {
+		if data.GetConsumerGroupId() == group {
+			if err := checkGroupInactive(group, data.GetState()); err != nil {
+				return err
+			}
+		}
+	}
+
+	cluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	if err := addApiKeyToCluster(cmd, cluster); err != nil {
+		return err
+	}
+
+	consumer, err := newConsumer(group, cluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	defer consumer.Close()
+
+	return importOffsets(cmd, consumer, archive, group, c.Config.EnableColor)
+}
@@ -0,0 +1,93 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+func (c *consumerCommand) newGroupOffsetImportCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import the offsets of a Kafka consumer group from a file.",
+		Long:  "Import the offsets of an inactive Kafka consumer group from a file written by `confluent kafka consumer group offset export`. The state of the group is checked with Kafka REST, which does not support altering consumer group offsets, so the offsets are committed with a Kafka client connected to \"--bootstrap\".\n\nWith `--translate-by-timestamp`, each partition is set to the first offset whose timestamp is at or after that of the next message the group would have consumed when exported, rather than to the exported offset. This keeps the position of the group when the topics were copied to this cluster, since their offsets usually differ.",
+		Args:  cobra.NoArgs,
+		RunE:  c.groupOffsetImportOnPrem,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview importing the offsets in "my-group.json" to consumer group "my-group".`,
+				Code: "confluent kafka consumer group offset import --file my-group.json --dry-run --url http://localhost:8082 --bootstrap localhost:9092",
+			},
+			examples.Example{
+				Text: `Import the offsets in "my-group.json", exported from another cluster, to consumer group "my-new-group".`,
+				Code: "confluent kafka consumer group offset import --file my-group.json --group my-new-group --translate-by-timestamp --url http://localhost:8082 --bootstrap localhost:9092",
+			},
+		),
+	}
+
+	addOffsetImportFlags(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+	pcmd.AddProtocolFlag(cmd)
+	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
+
+	return cmd
+}
+
+func (c *consumerCommand) groupOffsetImportOnPrem(cmd *cobra.Command, _ []string) error {
+	archive, group, err := readGroupOffsetImportFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	restClient, restContext, clusterId, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	groups, resp, err := restClient.ConsumerGroupV3Api.ListKafkaConsumerGroups(restContext, clusterId)
+	if err != nil {
+		return kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+	for _, data := range groups.Data {
+		if data.ConsumerGroupId == group {
+			if err := checkGroupInactive(group, data.State); err != nil {
+				return err
+			}
+		}
+	}
+
+	configMap, err := getOnPremConsumerConfigMap(cmd, c.clientID)
+	if err != nil {
+		return err
+	}
+	if err := configMap.SetKey("group.id", group); err != nil {
+		return err
+	}
+
+	consumer, err := newConsumerWithOverwrittenConfigs(configMap, "", nil)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	defer consumer.Close()
+
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
+		return err
+	}
+
+	return importOffsets(cmd, consumer, archive, group, c.Config.EnableColor)
+}
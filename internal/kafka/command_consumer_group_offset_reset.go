@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+)
+
+func (c *consumerCommand) newGroupOffsetResetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "reset <group>",
+		Short:             "Reset the offsets of a Kafka consumer group.",
+		Long:              "Reset the committed offsets of an inactive Kafka consumer group. Kafka REST does not support altering consumer group offsets, so the new offsets are committed with a Kafka client, which requires an API key for the cluster.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validGroupArgs),
+		RunE:              c.groupOffsetReset,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview resetting the offsets of consumer group "my-group" to the beginning of topic "my-topic".`,
+				Code: "confluent kafka consumer group offset reset my-group --topic my-topic --to-earliest --dry-run",
+			},
+			examples.Example{
+				Text: `Rewind the offsets of consumer group "my-group" on partitions 0 and 1 of topic "my-topic" by 100 messages.`,
+				Code: "confluent kafka consumer group offset reset my-group --topic my-topic:0,1 --shift-by -100",
+			},
+			examples.Example{
+				Text: `Reset the offsets of consumer group "my-group" to the first messages produced since the start of 2024.`,
+				Code: "confluent kafka consumer group offset reset my-group --to-datetime 2024-01-01T00:00:00Z",
+			},
+		),
+	}
+
+	addOffsetResetFlags(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	return cmd
+}
+
+func (c *consumerCommand) groupOffsetReset(cmd *cobra.Command, args []string) error {
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return err
+	}
+
+	group, err := kafkaREST.CloudClient.GetKafkaConsumerGroup(args[0])
+	if err != nil {
+		return err
+	}
+	if err := checkGroupInactive(args[0], group.GetState()); err != nil {
+		return err
+	}
+
+	cluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	if err := addApiKeyToCluster(cmd, cluster); err != nil {
+		return err
+	}
+
+	consumer, err := newConsumer(args[0], cluster, c.clientID, "", nil)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	defer consumer.Close()
+
+	return resetOffsets(cmd, consumer, args[0], c.Config.EnableColor)
+}
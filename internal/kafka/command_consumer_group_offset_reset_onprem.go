@@ -0,0 +1,83 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+func (c *consumerCommand) newGroupOffsetResetCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset <group>",
+		Short: "Reset the offsets of a Kafka consumer group.",
+		Long:  `Reset the committed offsets of an inactive Kafka consumer group. The state of the group is checked with Kafka REST, which does not support altering consumer group offsets, so the new offsets are committed with a Kafka client connected to "--bootstrap".`,
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.groupOffsetResetOnPrem,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Preview resetting the offsets of consumer group "my-group" to the beginning of topic "my-topic".`,
+				Code: "confluent kafka consumer group offset reset my-group --topic my-topic --to-earliest --dry-run --url http://localhost:8082 --bootstrap localhost:9092",
+			},
+			examples.Example{
+				Text: `Rewind the offsets of consumer group "my-group" on partitions 0 and 1 of topic "my-topic" by 100 messages, with SASL_SSL/OAUTHBEARER protocol enabled (using MDS token).`,
+				Code: "confluent kafka consumer group offset reset my-group --topic my-topic:0,1 --shift-by -100 --url https://localhost:8082 --bootstrap localhost:19091 --protocol SASL_SSL --sasl-mechanism OAUTHBEARER --ca-location my-cert.crt",
+			},
+		),
+	}
+
+	addOffsetResetFlags(cmd)
+	pcmd.AddDryRunFlag(cmd)
+	cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+	pcmd.AddProtocolFlag(cmd)
+	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
+
+	return cmd
+}
+
+func (c *consumerCommand) groupOffsetResetOnPrem(cmd *cobra.Command, args []string) error {
+	restClient, restContext, clusterId, err := initKafkaRest(c.AuthenticatedCLICommand, cmd)
+	if err != nil {
+		return err
+	}
+
+	group, resp, err := restClient.ConsumerGroupV3Api.GetKafkaConsumerGroup(restContext, clusterId, args[0])
+	if err != nil {
+		return kafkarest.NewError(restClient.GetConfig().BasePath, err, resp)
+	}
+	if err := checkGroupInactive(args[0], group.State); err != nil {
+		return err
+	}
+
+	configMap, err := getOnPremConsumerConfigMap(cmd, c.clientID)
+	if err != nil {
+		return err
+	}
+	if err := configMap.SetKey("group.id", args[0]); err != nil {
+		return err
+	}
+
+	consumer, err := newConsumerWithOverwrittenConfigs(configMap, "", nil)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	defer consumer.Close()
+
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
+		return err
+	}
+
+	return resetOffsets(cmd, consumer, args[0], c.Config.EnableColor)
+}
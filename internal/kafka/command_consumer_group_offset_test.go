@@ -0,0 +1,136 @@
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+type fakeOffsetResetClient struct {
+	partitions map[string]int32
+	committed  map[string]int64
+	watermarks [2]int64
+	timestamps map[string]int64
+}
+
+func (f *fakeOffsetResetClient) GetMetadata(_ *string, _ bool, _ int) (*ckafka.Metadata, error) {
+	metadata := &ckafka.Metadata{Topics: make(map[string]ckafka.TopicMetadata)}
+	for topic, count := range f.partitions {
+		partitions := make([]ckafka.PartitionMetadata, count)
+		for i := range partitions {
+			partitions[i].ID = int32(i)
+		}
+		metadata.Topics[topic] = ckafka.TopicMetadata{Topic: topic, Partitions: partitions}
+	}
+	return metadata, nil
+}
+
+func (f *fakeOffsetResetClient) Committed(partitions []ckafka.TopicPartition, _ int) ([]ckafka.TopicPartition, error) {
+	committed := make([]ckafka.TopicPartition, len(partitions))
+	for i, partition := range partitions {
+		committed[i] = partition
+		committed[i].Offset = ckafka.OffsetInvalid
+		if offset, ok := f.committed[topicPartitionKey(partition)]; ok {
+			committed[i].Offset = ckafka.Offset(offset)
+		}
+	}
+	return committed, nil
+}
+
+func (f *fakeOffsetResetClient) QueryWatermarkOffsets(_ string, _ int32, _ int) (int64, int64, error) {
+	return f.watermarks[0], f.watermarks[1], nil
+}
+
+func (f *fakeOffsetResetClient) OffsetsForTimes(times []ckafka.TopicPartition, _ int) ([]ckafka.TopicPartition, error) {
+	offsets := make([]ckafka.TopicPartition, len(times))
+	for i, partition := range times {
+		offsets[i] = partition
+		offsets[i].Offset = ckafka.OffsetEnd
+		if offset, ok := f.timestamps[topicPartitionKey(partition)]; ok {
+			offsets[i].Offset = ckafka.Offset(offset)
+		}
+	}
+	return offsets, nil
+}
+
+func TestParseTopicPartitions(t *testing.T) {
+	topics, err := parseTopicPartitions([]string{"orders", "payments:0, 2", "payments:5"})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]int32{"orders": nil, "payments": {0, 2, 5}}, topics)
+
+	_, err = parseTopicPartitions([]string{"orders:a"})
+	require.Error(t, err)
+
+	_, err = parseTopicPartitions([]string{":0"})
+	require.Error(t, err)
+}
+
+func TestComputeNewOffset(t *testing.T) {
+	offset := int64(500)
+	shift := int64(-30)
+	datetime := time.Now()
+
+	tests := []struct {
+		spec            *offsetResetSpec
+		current         int64
+		timestampOffset int64
+		expected        int64
+	}{
+		{spec: &offsetResetSpec{toEarliest: true}, current: 50, expected: 10},
+		{spec: &offsetResetSpec{toLatest: true}, current: 50, expected: 100},
+		{spec: &offsetResetSpec{toOffset: &offset}, current: 50, expected: 100},
+		{spec: &offsetResetSpec{shiftBy: &shift}, current: 50, expected: 20},
+		{spec: &offsetResetSpec{shiftBy: &shift}, current: 30, expected: 10},
+		{spec: &offsetResetSpec{toDatetime: &datetime}, current: 50, timestampOffset: 42, expected: 42},
+		{spec: &offsetResetSpec{toDatetime: &datetime}, current: 50, timestampOffset: -1, expected: 100},
+	}
+
+	for _, test := range tests {
+		newOffset, err := computeNewOffset(test.spec, test.current, 10, 100, test.timestampOffset)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, newOffset)
+	}
+
+	_, err := computeNewOffset(&offsetResetSpec{shiftBy: &shift}, -1, 10, 100, 0)
+	require.Error(t, err)
+}
+
+func TestPlanOffsetReset(t *testing.T) {
+	client := &fakeOffsetResetClient{
+		partitions: map[string]int32{"orders": 2, "payments": 1, "_schemas": 1},
+		committed:  map[string]int64{"orders:1": 40, "payments:0": 70, "_schemas:0": 5},
+		watermarks: [2]int64{0, 100},
+		timestamps: map[string]int64{"orders:1": 25},
+	}
+
+	partitions, err := getOffsetResetPartitions(client, nil)
+	require.NoError(t, err)
+	require.Len(t, partitions, 2)
+	require.Equal(t, "orders:1", topicPartitionKey(partitions[0]))
+	require.Equal(t, "payments:0", topicPartitionKey(partitions[1]))
+
+	datetime := time.Now()
+	newOffsets, err := planOffsetReset(client, partitions, &offsetResetSpec{toDatetime: &datetime})
+	require.NoError(t, err)
+	require.Equal(t, ckafka.Offset(25), newOffsets[0].Offset)
+	require.Equal(t, ckafka.Offset(100), newOffsets[1].Offset)
+
+	partitions, err = getOffsetResetPartitions(client, map[string][]int32{"orders": nil})
+	require.NoError(t, err)
+	require.Len(t, partitions, 2)
+	require.Equal(t, ckafka.OffsetInvalid, partitions[0].Offset)
+
+	_, err = getOffsetResetPartitions(client, map[string][]int32{"orders": {3}})
+	require.Error(t, err)
+
+	_, err = getOffsetResetPartitions(client, map[string][]int32{"missing": nil})
+	require.Error(t, err)
+}
+
+func TestCheckGroupInactive(t *testing.T) {
+	require.NoError(t, checkGroupInactive("group", "EMPTY"))
+	require.Error(t, checkGroupInactive("group", "STABLE"))
+}
@@ -50,6 +50,7 @@ func (c *consumerCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(consumerOut))
 	for _, consumer := range consumers {
 		list.Add(&consumerOut{
 			ConsumerGroupId: consumer.GetConsumerGroupId(),
@@ -47,6 +47,7 @@ func (c *linkCommand) configurationList(cmd *cobra.Command, args []string) error
 	})
 
 	list := output.NewList(cmd)
+	list.Columns(new(linkConfigurationSerializedOut))
 	for _, config := range configList {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&linkConfigurationHumanOut{
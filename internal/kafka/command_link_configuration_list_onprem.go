@@ -49,6 +49,7 @@ func (c *linkCommand) configurationListOnPrem(cmd *cobra.Command, args []string)
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(linkConfigurationSerializedOut))
 	for _, config := range configList {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&linkConfigurationHumanOut{
@@ -53,6 +53,7 @@ func (c *mirrorCommand) describe(cmd *cobra.Command, args []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(mirrorOut))
 	for _, partitionLag := range mirror.GetMirrorLags().Items {
 		list.Add(&mirrorOut{
 			LinkName:              mirror.GetLinkName(),
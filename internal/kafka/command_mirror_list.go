@@ -82,6 +82,7 @@ func (c *mirrorCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(mirrorOut))
 	for _, mirror := range mirrors {
 		var maxLag int64 = 0
 		for _, mirrorLag := range mirror.GetMirrorLags().Items {
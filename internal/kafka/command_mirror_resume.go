@@ -67,6 +67,7 @@ func (c *mirrorCommand) resume(cmd *cobra.Command, args []string) error {
 
 func printAlterMirrorResult(cmd *cobra.Command, results []kafkarestv3.AlterMirrorStatusResponseData) error {
 	list := output.NewList(cmd)
+	list.Columns(new(mirrorOut))
 	for _, result := range results {
 		errorMessage := result.GetErrorMessage()
 
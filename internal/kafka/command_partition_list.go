@@ -51,6 +51,7 @@ func (c *partitionCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(partitionOut))
 	for _, partition := range partitions {
 		list.Add(&partitionOut{
 			ClusterId:   partition.GetClusterId(),
@@ -50,6 +50,7 @@ func (c *partitionCommand) listOnPrem(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(partitionOut))
 	for _, partition := range partitions.Data {
 		list.Add(&partitionOut{
 			ClusterId:   partition.ClusterId,
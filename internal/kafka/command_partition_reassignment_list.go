@@ -90,6 +90,7 @@ func (c *partitionCommand) reassignmentList(cmd *cobra.Command, args []string) e
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(getReassignmentOut))
 	for _, reassignment := range reassignments.Data {
 		list.Add(&getReassignmentOut{
 			ClusterId:        reassignment.ClusterId,
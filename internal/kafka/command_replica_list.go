@@ -100,6 +100,7 @@ func (c *replicaCommand) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(replicaSerializedOut))
 	for _, replica := range replicas.Data {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&replicaHumanOut{
@@ -45,6 +45,8 @@ func newTopicCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comman
 		cmd.AddCommand(c.newCreateCommand())
 		cmd.AddCommand(c.newDeleteCommand())
 		cmd.AddCommand(c.newDescribeCommand())
+		cmd.AddCommand(c.newExportCommand())
+		cmd.AddCommand(c.newImportCommand())
 		cmd.AddCommand(c.newListCommand())
 		cmd.AddCommand(c.newProduceCommand())
 		cmd.AddCommand(c.newUpdateCommand())
@@ -56,6 +58,8 @@ func newTopicCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Comman
 		cmd.AddCommand(c.newCreateCommandOnPrem())
 		cmd.AddCommand(c.newDeleteCommandOnPrem())
 		cmd.AddCommand(c.newDescribeCommandOnPrem())
+		cmd.AddCommand(c.newExportCommandOnPrem())
+		cmd.AddCommand(c.newImportCommandOnPrem())
 		cmd.AddCommand(c.newListCommandOnPrem())
 		cmd.AddCommand(c.newProduceCommandOnPrem())
 		cmd.AddCommand(c.newUpdateCommandOnPrem())
@@ -18,8 +18,11 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/output"
 	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
 	"github.com/confluentinc/cli/v3/pkg/serdes"
+	"github.com/confluentinc/cli/v3/pkg/utils"
 )
 
+var consumeOutputFormats = []string{output.Human.String(), output.JSON.String()}
+
 func (c *command) newConsumeCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "consume <topic>",
@@ -34,6 +37,18 @@ func (c *command) newConsumeCommand() *cobra.Command {
 				Text: `Consume items from topic "my-topic" and press "Ctrl-C" to exit.`,
 				Code: "confluent kafka topic consume my-topic --from-beginning",
 			},
+			examples.Example{
+				Text: `Consume the first 10 items from topic "my-topic", failing if they are not received within 30 seconds.`,
+				Code: "confluent kafka topic consume my-topic --from-beginning --max-messages 10 --timeout 30s",
+			},
+			examples.Example{
+				Text: `Consume items from topic "my-topic" as JSON objects, which can be produced again with "--input-format json".`,
+				Code: "confluent kafka topic consume my-topic --from-beginning --output json",
+			},
+			examples.Example{
+				Text: `Consume Avro items from topic "my-topic", writing those which cannot be deserialized to the dead letter topic "my-topic-dlq".`,
+				Code: "confluent kafka topic consume my-topic --value-format avro --from-beginning --on-error dlq=my-topic-dlq",
+			},
 		),
 	}
 
@@ -41,13 +56,21 @@ func (c *command) newConsumeCommand() *cobra.Command {
 	cmd.Flags().BoolP("from-beginning", "b", false, "Consume from beginning of the topic.")
 	cmd.Flags().Int64("offset", 0, "The offset from the beginning to consume from.")
 	cmd.Flags().Int32("partition", -1, "The partition to consume from.")
+	cmd.Flags().Int64("from-timestamp", 0, "Consume from the earliest offset whose timestamp is at or after this time, in milliseconds since the epoch.")
+	cmd.Flags().Int("max-messages", 0, "Exit after consuming this many messages.")
+	cmd.Flags().Int64("until-offset", 0, "Exit after consuming every partition up to, but not including, this offset.")
+	cmd.Flags().Bool("exit-on-eof", false, "Exit after reaching the end of every partition.")
+	cmd.Flags().Duration("timeout", 0, `Exit with an error if "--max-messages", "--until-offset", or "--exit-on-eof" is not satisfied within this duration (for example, "30s").`)
 	pcmd.AddKeyFormatFlag(cmd)
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().Bool("print-key", false, "Print key of the message.")
 	cmd.Flags().Bool("print-offset", false, "Print partition number and offset of the message.")
 	cmd.Flags().Bool("full-header", false, "Print complete content of message headers.")
 	cmd.Flags().String("delimiter", "\t", "The delimiter separating each key and value.")
+	cmd.Flags().StringP(output.FlagName, "o", output.Human.String(), `Specify the output format as "human" or "json". With "json", each message is printed as a single-line JSON object including its key, headers, and metadata.`)
+	pcmd.RegisterFlagCompletionFunc(cmd, output.FlagName, func(_ *cobra.Command, _ []string) []string { return consumeOutputFormats })
 	cmd.Flags().Bool("timestamp", false, "Print message timestamp in milliseconds.")
+	addOnErrorFlags(cmd)
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
 	pcmd.AddConsumerConfigFileFlag(cmd)
 	cmd.Flags().String("schema-registry-context", "", "The Schema Registry context under which to look up schema ID.")
@@ -63,7 +86,12 @@ func (c *command) newConsumeCommand() *cobra.Command {
 	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
 
 	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
-	cmd.MarkFlagsMutuallyExclusive("from-beginning", "offset")
+	cmd.MarkFlagsMutuallyExclusive("from-beginning", "offset", "from-timestamp")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "print-key")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "full-header")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "timestamp")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "delimiter")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "print-offset")
 
 	return cmd
 }
@@ -116,6 +144,24 @@ func (c *command) consume(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	format, err := getConsumeOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	bounds, err := getConsumerBounds(cmd)
+	if err != nil {
+		return err
+	}
+
+	errorPolicy, err := getErrorPolicy(cmd, func() (*ckafka.Producer, error) {
+		return newProducer(cluster, c.clientID, "", nil)
+	})
+	if err != nil {
+		return err
+	}
+	defer errorPolicy.close()
+
 	configFile, err := cmd.Flags().GetString("config-file")
 	if err != nil {
 		return err
@@ -155,8 +201,11 @@ func (c *command) consume(cmd *cobra.Command, args []string) error {
 		Index:   partition,
 	}
 
-	rebalanceCallback := GetRebalanceCallback(offset, partitionFilter)
-	if consumeFromGroupOffset && !cmd.Flags().Changed("from-beginning") && !cmd.Flags().Changed("offset") {
+	rebalanceCallback, err := getConsumeRebalanceCallback(cmd, offset, partitionFilter)
+	if err != nil {
+		return err
+	}
+	if consumeFromGroupOffset && !cmd.Flags().Changed("from-beginning") && !cmd.Flags().Changed("offset") && !cmd.Flags().Changed("from-timestamp") {
 		rebalanceCallback = nil
 	}
 	if err := consumer.Subscribe(topic, rebalanceCallback); err != nil {
@@ -218,7 +267,82 @@ func (c *command) consume(cmd *cobra.Command, args []string) error {
 			Timestamp:   timestamp,
 			Delimiter:   delimiter,
 			SchemaPath:  schemaPath,
+			Output:      format,
 		},
+		Bounds:      bounds,
+		ErrorPolicy: errorPolicy,
 	}
 	return RunConsumer(consumer, groupHandler)
 }
+
+func getConsumeOutputFormat(cmd *cobra.Command) (output.Format, error) {
+	format, err := cmd.Flags().GetString(output.FlagName)
+	if err != nil {
+		return output.Human, err
+	}
+
+	if !slices.Contains(consumeOutputFormats, format) {
+		return output.Human, fmt.Errorf("invalid value for `--%s`: must be %s", output.FlagName, utils.ArrayToCommaDelimitedString(consumeOutputFormats, "or"))
+	}
+
+	return output.GetFormat(cmd), nil
+}
+
+func getConsumerBounds(cmd *cobra.Command) (ConsumerBounds, error) {
+	maxMessages, err := cmd.Flags().GetInt("max-messages")
+	if err != nil {
+		return ConsumerBounds{}, err
+	}
+	if maxMessages < 0 {
+		return ConsumerBounds{}, fmt.Errorf("`--max-messages` must be a non-negative integer")
+	}
+
+	exitOnEOF, err := cmd.Flags().GetBool("exit-on-eof")
+	if err != nil {
+		return ConsumerBounds{}, err
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return ConsumerBounds{}, err
+	}
+
+	bounds := ConsumerBounds{
+		MaxMessages: maxMessages,
+		ExitOnEOF:   exitOnEOF,
+		Timeout:     timeout,
+	}
+
+	if cmd.Flags().Changed("until-offset") {
+		untilOffset, err := cmd.Flags().GetInt64("until-offset")
+		if err != nil {
+			return ConsumerBounds{}, err
+		}
+		if untilOffset < 0 {
+			return ConsumerBounds{}, fmt.Errorf("`--until-offset` must be a non-negative integer")
+		}
+		bounds.UntilOffset = &untilOffset
+	}
+
+	if bounds.Timeout > 0 && !bounds.isSet() {
+		return ConsumerBounds{}, fmt.Errorf("`--timeout` requires `--max-messages`, `--until-offset`, or `--exit-on-eof`")
+	}
+
+	return bounds, nil
+}
+
+func getConsumeRebalanceCallback(cmd *cobra.Command, offset ckafka.Offset, partitionFilter PartitionFilter) (func(*ckafka.Consumer, ckafka.Event) error, error) {
+	if !cmd.Flags().Changed("from-timestamp") {
+		return GetRebalanceCallback(offset, partitionFilter), nil
+	}
+
+	timestamp, err := cmd.Flags().GetInt64("from-timestamp")
+	if err != nil {
+		return nil, err
+	}
+	if timestamp < 0 {
+		return nil, fmt.Errorf("`--from-timestamp` must be a non-negative integer")
+	}
+
+	return GetTimestampRebalanceCallback(timestamp, partitionFilter), nil
+}
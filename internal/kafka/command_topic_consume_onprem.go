@@ -45,12 +45,21 @@ func (c *command) newConsumeCommandOnPrem() *cobra.Command {
 	cmd.Flags().BoolP("from-beginning", "b", false, "Consume from beginning of the topic.")
 	cmd.Flags().Int64("offset", 0, "The offset from the beginning to consume from.")
 	cmd.Flags().Int32("partition", -1, "The partition to consume from.")
+	cmd.Flags().Int64("from-timestamp", 0, "Consume from the earliest offset whose timestamp is at or after this time, in milliseconds since the epoch.")
+	cmd.Flags().Int("max-messages", 0, "Exit after consuming this many messages.")
+	cmd.Flags().Int64("until-offset", 0, "Exit after consuming every partition up to, but not including, this offset.")
+	cmd.Flags().Bool("exit-on-eof", false, "Exit after reaching the end of every partition.")
+	cmd.Flags().Duration("timeout", 0, `Exit with an error if "--max-messages", "--until-offset", or "--exit-on-eof" is not satisfied within this duration (for example, "30s").`)
 	pcmd.AddKeyFormatFlag(cmd)
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().Bool("print-key", false, "Print key of the message.")
+	cmd.Flags().Bool("print-offset", false, "Print partition number and offset of the message.")
 	cmd.Flags().Bool("full-header", false, "Print complete content of message headers.")
 	cmd.Flags().Bool("timestamp", false, "Print message timestamp in milliseconds.")
 	cmd.Flags().String("delimiter", "\t", "The delimiter separating each key and value.")
+	cmd.Flags().StringP(output.FlagName, "o", output.Human.String(), `Specify the output format as "human" or "json". With "json", each message is printed as a single-line JSON object including its key, headers, and metadata.`)
+	pcmd.RegisterFlagCompletionFunc(cmd, output.FlagName, func(_ *cobra.Command, _ []string) []string { return consumeOutputFormats })
+	addOnErrorFlags(cmd)
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
 	pcmd.AddConsumerConfigFileFlag(cmd)
 	cmd.Flags().String("schema-registry-endpoint", "", "The URL of the Schema Registry cluster.")
@@ -60,7 +69,12 @@ func (c *command) newConsumeCommandOnPrem() *cobra.Command {
 	cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
 
 	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
-	cmd.MarkFlagsMutuallyExclusive("from-beginning", "offset")
+	cmd.MarkFlagsMutuallyExclusive("from-beginning", "offset", "from-timestamp")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "print-key")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "full-header")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "timestamp")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "delimiter")
+	cmd.MarkFlagsMutuallyExclusive(output.FlagName, "print-offset")
 
 	return cmd
 }
@@ -71,6 +85,11 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	printOffset, err := cmd.Flags().GetBool("print-offset")
+	if err != nil {
+		return err
+	}
+
 	fullHeader, err := cmd.Flags().GetBool("full-header")
 	if err != nil {
 		return err
@@ -86,6 +105,32 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	format, err := getConsumeOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	bounds, err := getConsumerBounds(cmd)
+	if err != nil {
+		return err
+	}
+
+	errorPolicy, err := getErrorPolicy(cmd, func() (*ckafka.Producer, error) {
+		producer, err := newOnPremProducer(cmd, c.clientID, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := refreshOAuthBearerToken(cmd, producer, c.Context); err != nil {
+			producer.Close()
+			return nil, err
+		}
+		return producer, nil
+	})
+	if err != nil {
+		return err
+	}
+	defer errorPolicy.close()
+
 	keyFormat, err := cmd.Flags().GetString("key-format")
 	if err != nil {
 		return err
@@ -114,7 +159,7 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 	}
 	log.CliLogger.Tracef("Create consumer succeeded")
 
-	if err := c.refreshOAuthBearerToken(cmd, consumer); err != nil {
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
 		return err
 	}
 
@@ -143,7 +188,10 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 		Index:   partition,
 	}
 
-	rebalanceCallback := GetRebalanceCallback(offset, partitionFilter)
+	rebalanceCallback, err := getConsumeRebalanceCallback(cmd, offset, partitionFilter)
+	if err != nil {
+		return err
+	}
 	if err := consumer.Subscribe(topicName, rebalanceCallback); err != nil {
 		return err
 	}
@@ -151,7 +199,7 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 	output.ErrPrintln(c.Config.EnableColor, errors.StartingConsumerMsg)
 
 	var srClient *schemaregistry.Client
-	if slices.Contains(serdes.SchemaBasedFormats, valueFormat) {
+	if slices.Contains(serdes.SchemaBasedFormats, valueFormat) || slices.Contains(serdes.SchemaBasedFormats, keyFormat) {
 		srClient, err = c.GetSchemaRegistryClient(cmd)
 		if err != nil {
 			return err
@@ -172,12 +220,16 @@ func (c *command) consumeOnPrem(cmd *cobra.Command, args []string) error {
 		ValueFormat: valueFormat,
 		Out:         cmd.OutOrStdout(),
 		Properties: ConsumerProperties{
-			PrintKey:   printKey,
-			FullHeader: fullHeader,
-			Timestamp:  timestamp,
-			Delimiter:  delimiter,
-			SchemaPath: dir,
+			PrintKey:    printKey,
+			PrintOffset: printOffset,
+			FullHeader:  fullHeader,
+			Timestamp:   timestamp,
+			Delimiter:   delimiter,
+			SchemaPath:  dir,
+			Output:      format,
 		},
+		Bounds:      bounds,
+		ErrorPolicy: errorPolicy,
 	}
 	return RunConsumer(consumer, groupHandler)
 }
@@ -62,6 +62,7 @@ func (c *command) describe(cmd *cobra.Command, args []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(topicConfigurationOut))
 	for _, config := range configs {
 		list.Add(&topicConfigurationOut{
 			Name:     config.GetName(),
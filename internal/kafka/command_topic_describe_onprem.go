@@ -57,6 +57,10 @@ func (c *command) newDescribeCommandOnPrem() *cobra.Command {
 }
 
 func (c *command) describeOnPrem(cmd *cobra.Command, args []string) error {
+	if err := output.ValidateNotDelimited(cmd); err != nil {
+		return err
+	}
+
 	// Parse Args
 	topicName := args[0]
 
@@ -151,6 +155,7 @@ func DescribeTopic(cmd *cobra.Command, restClient *kafkarestv3.APIClient, restCo
 	output.Println(false, "Configuration")
 	output.Println(false, "")
 	list = output.NewList(cmd)
+	list.Columns(new(broker.ConfigOut))
 	for name, value := range topic.Configs {
 		list.Add(&broker.ConfigOut{
 			Name:  name,
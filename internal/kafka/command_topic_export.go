@@ -0,0 +1,165 @@
+package kafka
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+)
+
+func (c *command) newExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "export <topic>",
+		Short:             "Export the messages of a Kafka topic to a file.",
+		Long:              "Export every message of a Kafka topic, from the beginning of each partition to its end, to a file which can be loaded with `confluent kafka topic import`.\n\nKeys, values, and headers are stored as raw bytes, along with each message's partition, offset, and timestamp. Messages encoded with schemas from Schema Registry are detected by their schema IDs, and the schemas they use and any schemas those reference are stored as well.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.export,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export topic "my-topic" to "my-topic.json".`,
+				Code: "confluent kafka topic export my-topic --file my-topic.json",
+			},
+			examples.Example{
+				Text: `Export topic "my-topic", whose values are encoded with Avro schemas, to "my-topic.json".`,
+				Code: "confluent kafka topic export my-topic --file my-topic.json --value-format avro",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "The file to write the exported messages to.")
+	pcmd.AddKeyFormatFlag(cmd)
+	pcmd.AddValueFormatFlag(cmd)
+	cmd.Flags().Duration("timeout", 0, `Exit with an error if the end of every partition is not reached within this duration (for example, "30s").`)
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
+	pcmd.AddConsumerConfigFileFlag(cmd)
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API secret.")
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) export(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	cluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	if err := addApiKeyToCluster(cmd, cluster); err != nil {
+		return err
+	}
+
+	archive, timeout, err := getExportFlags(cmd, topic)
+	if err != nil {
+		return err
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	group := fmt.Sprintf("confluent_cli_export_%s", uuid.New())
+	consumer, err := newConsumer(group, cluster, c.clientID, configFile, config)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateConsumerErrorMsg, err)
+	}
+	log.CliLogger.Trace("Create consumer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromConsumer(consumer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := c.validateTopic(adminClient, topic, cluster); err != nil {
+		return err
+	}
+
+	if err := ExportTopic(consumer, archive, cmd.ErrOrStderr(), timeout); err != nil {
+		return err
+	}
+
+	if archive.hasSchemas() {
+		srClient, err := c.GetSchemaRegistryClient(cmd)
+		if err != nil {
+			if err.Error() == errors.NotLoggedInErrorMsg {
+				return new(errors.SRNotAuthenticatedError)
+			}
+			return err
+		}
+		if err := archive.FetchSchemas(srClient); err != nil {
+			return err
+		}
+	}
+
+	return c.writeTopicArchive(cmd, archive)
+}
+
+func getExportFlags(cmd *cobra.Command, topic string) (*TopicArchive, time.Duration, error) {
+	keyFormat, err := cmd.Flags().GetString("key-format")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	valueFormat, err := cmd.Flags().GetString("value-format")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, format := range []string{keyFormat, valueFormat} {
+		if _, err := serdes.GetDeserializationProvider(format); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return NewTopicArchive(topic, keyFormat, valueFormat), timeout, nil
+}
+
+func (c *command) writeTopicArchive(cmd *cobra.Command, archive *TopicArchive) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	if err := archive.Write(file); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported %d message(s) from topic \"%s\" to \"%s\".\n", len(archive.Records), archive.Topic, file)
+	return nil
+}
@@ -0,0 +1,108 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/log"
+)
+
+func (c *command) newExportCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <topic>",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.exportOnPrem,
+		Short: "Export the messages of a Kafka topic to a file.",
+		Long:  "Export every message of a Kafka topic, from the beginning of each partition to its end, to a file which can be loaded with `confluent kafka topic import`.\n\nKeys, values, and headers are stored as raw bytes, along with each message's partition, offset, and timestamp. Messages encoded with schemas from Schema Registry are detected by their schema IDs, and the schemas they use and any schemas those reference are stored as well.",
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export topic "my_topic" to "my_topic.json" with SSL protocol and SSL verification enabled.`,
+				Code: "confluent kafka topic export my_topic --file my_topic.json --protocol SSL --bootstrap localhost:19091 --ca-location my-cert.crt",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+	pcmd.AddProtocolFlag(cmd)
+	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+	cmd.Flags().String("file", "", "The file to write the exported messages to.")
+	pcmd.AddKeyFormatFlag(cmd)
+	pcmd.AddValueFormatFlag(cmd)
+	cmd.Flags().Duration("timeout", 0, `Exit with an error if the end of every partition is not reached within this duration (for example, "30s").`)
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
+	pcmd.AddConsumerConfigFileFlag(cmd)
+	cmd.Flags().String("schema-registry-endpoint", "", "The URL of the Schema Registry cluster.")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) exportOnPrem(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	archive, timeout, err := getExportFlags(cmd, topic)
+	if err != nil {
+		return err
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	consumer, err := newOnPremConsumer(cmd, c.clientID, configFile, config)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	log.CliLogger.Tracef("Create consumer succeeded")
+
+	if err := refreshOAuthBearerToken(cmd, consumer, c.Context); err != nil {
+		return err
+	}
+
+	adminClient, err := ckafka.NewAdminClientFromConsumer(consumer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := ValidateTopic(adminClient, topic); err != nil {
+		return err
+	}
+
+	if err := ExportTopic(consumer, archive, cmd.ErrOrStderr(), timeout); err != nil {
+		return err
+	}
+
+	if archive.hasSchemas() {
+		srClient, err := c.GetSchemaRegistryClient(cmd)
+		if err != nil {
+			return err
+		}
+		if err := archive.FetchSchemas(srClient); err != nil {
+			return err
+		}
+	}
+
+	return c.writeTopicArchive(cmd, archive)
+}
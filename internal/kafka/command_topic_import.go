@@ -0,0 +1,133 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *command) newImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "import <topic>",
+		Short:             "Import messages to a Kafka topic from a file.",
+		Long:              "Import messages to a Kafka topic from a file written by `confluent kafka topic export`.\n\nMessages keep their keys, values, headers, and timestamps, and are produced to the partition they were exported from unless `--ignore-partitions` is set. Schemas stored in the file are registered under the topic's subjects, and the schema IDs of the messages are rewritten to match.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
+		RunE:              c.importTopic,
+		Annotations:       map[string]string{pcmd.RunRequirement: pcmd.RequireCloudLogin},
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Import the messages in "my-topic.json" to topic "my-topic".`,
+				Code: "confluent kafka topic import my-topic --file my-topic.json",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "The file to read the imported messages from.")
+	cmd.Flags().Bool("ignore-partitions", false, "Let the producer choose the partition of each message, rather than using the partition it was exported from.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
+	pcmd.AddProducerConfigFileFlag(cmd)
+	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
+	cmd.Flags().String("schema-registry-api-key", "", "Schema registry API key.")
+	cmd.Flags().String("schema-registry-api-secret", "", "Schema registry API secret.")
+	pcmd.AddApiKeyFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddApiSecretFlag(cmd)
+	pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) importTopic(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	cluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
+	if err != nil {
+		return err
+	}
+
+	if err := addApiKeyToCluster(cmd, cluster); err != nil {
+		return err
+	}
+
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	archive, err := ReadTopicArchive(file)
+	if err != nil {
+		return err
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	producer, err := newProducer(cluster, c.clientID, configFile, config)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateProducerErrorMsg, err)
+	}
+	defer producer.Close()
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := c.validateTopic(adminClient, topic, cluster); err != nil {
+		return err
+	}
+
+	if archive.hasSchemas() {
+		srClient, err := c.GetSchemaRegistryClient(cmd)
+		if err != nil {
+			if err.Error() == errors.NotLoggedInErrorMsg {
+				return new(errors.SRNotAuthenticatedError)
+			}
+			return err
+		}
+		if err := archive.RegisterSchemas(srClient, topic); err != nil {
+			return err
+		}
+	}
+
+	return c.importTopicArchive(cmd, producer, archive, topic, file)
+}
+
+func (c *command) importTopicArchive(cmd *cobra.Command, producer *ckafka.Producer, archive *TopicArchive, topic, file string) error {
+	ignorePartitions, err := cmd.Flags().GetBool("ignore-partitions")
+	if err != nil {
+		return err
+	}
+
+	count, err := ImportTopic(producer, archive, topic, !ignorePartitions)
+	if err != nil {
+		return fmt.Errorf("imported %d of %d message(s) before failing: %w", count, len(archive.Records), err)
+	}
+
+	output.Printf(c.Config.EnableColor, "Imported %d message(s) from \"%s\" to topic \"%s\".\n", count, file, topic)
+	return nil
+}
@@ -0,0 +1,111 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/log"
+)
+
+func (c *command) newImportCommandOnPrem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <topic>",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.importTopicOnPrem,
+		Short: "Import messages to a Kafka topic from a file.",
+		Long:  "Import messages to a Kafka topic from a file written by `confluent kafka topic export`.\n\nMessages keep their keys, values, headers, and timestamps, and are produced to the partition they were exported from unless `--ignore-partitions` is set. Schemas stored in the file are registered under the topic's subjects, and the schema IDs of the messages are rewritten to match.",
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Import the messages in "my_topic.json" to topic "my_topic" with SSL protocol and SSL verification enabled.`,
+				Code: "confluent kafka topic import my_topic --file my_topic.json --protocol SSL --bootstrap localhost:18091 --ca-location my-cert.crt",
+			},
+		),
+	}
+
+	cmd.Flags().AddFlagSet(pcmd.OnPremAuthenticationSet())
+	pcmd.AddProtocolFlag(cmd)
+	pcmd.AddMechanismFlag(cmd, c.AuthenticatedCLICommand)
+	cmd.Flags().String("file", "", "The file to read the imported messages from.")
+	cmd.Flags().Bool("ignore-partitions", false, "Let the producer choose the partition of each message, rather than using the partition it was exported from.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
+	pcmd.AddProducerConfigFileFlag(cmd)
+	cmd.Flags().String("schema-registry-endpoint", "", "The URL of the Schema Registry cluster.")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("bootstrap"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) importTopicOnPrem(cmd *cobra.Command, args []string) error {
+	topic := args[0]
+
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	archive, err := ReadTopicArchive(file)
+	if err != nil {
+		return err
+	}
+
+	configFile, err := cmd.Flags().GetString("config-file")
+	if err != nil {
+		return err
+	}
+	config, err := cmd.Flags().GetStringSlice("config")
+	if err != nil {
+		return err
+	}
+
+	producer, err := newOnPremProducer(cmd, c.clientID, configFile, config)
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateProducerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	defer producer.Close()
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	if err := refreshOAuthBearerToken(cmd, producer, c.Context); err != nil {
+		return err
+	}
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	if err := ValidateTopic(adminClient, topic); err != nil {
+		return err
+	}
+
+	if archive.hasSchemas() {
+		if c.Context.State == nil { // require log-in to use oauthbearer token
+			return errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
+		}
+		srClient, err := c.GetSchemaRegistryClient(cmd)
+		if err != nil {
+			return err
+		}
+		if err := archive.RegisterSchemas(srClient, topic); err != nil {
+			return err
+		}
+	}
+
+	return c.importTopicArchive(cmd, producer, archive, topic, file)
+}
@@ -37,6 +37,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(topicOut))
 	for _, topic := range topics {
 		list.Add(&topicOut{Name: topic.GetTopicName()})
 	}
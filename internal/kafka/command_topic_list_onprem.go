@@ -55,6 +55,7 @@ func ListTopics(cmd *cobra.Command, restClient *kafkarestv3.APIClient, restConte
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(topicOut))
 	for _, topic := range topics.Data {
 		list.Add(&topicOut{Name: topic.TopicName})
 	}
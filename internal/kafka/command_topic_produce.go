@@ -2,11 +2,14 @@ package kafka
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/antihax/optional"
 	"github.com/spf13/cobra"
@@ -17,19 +20,92 @@ import (
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/log"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
 	"github.com/confluentinc/cli/v3/pkg/serdes"
+	"github.com/confluentinc/cli/v3/pkg/utils"
 )
 
 const (
 	missingKeyOrValueErrorMsg     = "missing key or value in message"
 	missingOrMalformedKeyErrorMsg = "missing or malformed key in message"
+	malformedJsonRecordErrorMsg   = "failed to parse message as a JSON object: %w"
+	loadSchemaSuggestions         = "Specify a schema by passing a schema ID or the path to a schema file to the `--schema` flag."
 )
 
+// produceRecord is a single line of input when producing with `--input-format json`.
+type produceRecord struct {
+	Key       json.RawMessage `json:"key"`
+	Value     json.RawMessage `json:"value"`
+	Headers   produceHeaders  `json:"headers"`
+	Partition *int32          `json:"partition"`
+	Timestamp *int64          `json:"timestamp"`
+
+	// The metadata printed by `kafka topic consume --output json` is accepted and ignored, so consumed messages can be produced again.
+	Topic         json.RawMessage `json:"topic"`
+	Offset        json.RawMessage `json:"offset"`
+	KeySchemaId   json.RawMessage `json:"key_schema_id"`
+	ValueSchemaId json.RawMessage `json:"value_schema_id"`
+}
+
+// produceHeaders are the headers of a JSON record in their order of input, including repeated keys. Headers are either
+// an object of keys and values, or an array of objects with "key" and "value" fields. A null value produces a header without a value.
+type produceHeaders []ckafka.Header
+
+type produceHeader struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value"`
+}
+
+func (h *produceHeaders) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case nil:
+		return nil
+	case json.Delim('['):
+		var headers []produceHeader
+		if err := json.Unmarshal(data, &headers); err != nil {
+			return err
+		}
+		for _, header := range headers {
+			*h = append(*h, newProduceHeader(header.Key, header.Value))
+		}
+		return nil
+	case json.Delim('{'):
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			var value *string
+			if err := decoder.Decode(&value); err != nil {
+				return fmt.Errorf(`invalid value for header "%s": %w`, key, err)
+			}
+			*h = append(*h, newProduceHeader(key.(string), value))
+		}
+		return nil
+	default:
+		return fmt.Errorf(`"headers" must be an object or an array`)
+	}
+}
+
+func newProduceHeader(key string, value *string) ckafka.Header {
+	header := ckafka.Header{Key: key}
+	if value != nil {
+		header.Value = []byte(*value)
+	}
+	return header
+}
+
 func (c *command) newProduceCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:               "produce <topic>",
 		Short:             "Produce messages to a Kafka topic.",
-		Long:              "Produce messages to a Kafka topic.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.",
+		Long:              "Produce messages to a Kafka topic.\n\nTo set message headers, partitions, or timestamps, use `--input-format json` and pass one JSON object per line.",
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: pcmd.NewValidArgsFunction(c.validArgs),
 		RunE:              c.produce,
@@ -42,8 +118,11 @@ func (c *command) newProduceCommand() *cobra.Command {
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().String("key-references", "", "The path to the message key schema references file.")
 	cmd.Flags().String("references", "", "The path to the message value schema references file.")
+	addMessageTypeFlags(cmd)
+	addSubjectNameStrategyFlags(cmd)
 	cmd.Flags().Bool("parse-key", false, "Parse key from the message.")
 	cmd.Flags().String("delimiter", ":", "The delimiter separating each key and value.")
+	pcmd.AddInputFormatFlag(cmd)
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
 	pcmd.AddProducerConfigFileFlag(cmd)
 	cmd.Flags().String("schema-registry-endpoint", "", "Endpoint for Schema Registry cluster.")
@@ -74,6 +153,10 @@ func (c *command) newProduceCommand() *cobra.Command {
 }
 
 func (c *command) produce(cmd *cobra.Command, args []string) error {
+	if err := ValidateInputFormat(cmd); err != nil {
+		return err
+	}
+
 	topic := args[0]
 
 	cluster, err := c.Context.GetKafkaClusterForCommand(c.V2Client)
@@ -100,8 +183,8 @@ func (c *command) produce(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if cmd.Flags().Changed("key-format") && !parseKey {
-		return fmt.Errorf("`--parse-key` must be set when `key-format` is set")
+	if err := validateKeyFormat(cmd, parseKey); err != nil {
+		return err
 	}
 
 	configFile, err := cmd.Flags().GetString("config-file")
@@ -133,31 +216,6 @@ func (c *command) produce(cmd *cobra.Command, args []string) error {
 	return ProduceToTopic(cmd, keyMetaInfo, valueMetaInfo, topic, keySerializer, valueSerializer, producer)
 }
 
-func (c *command) registerSchema(cmd *cobra.Command, schemaCfg *sr.RegisterSchemaConfigs) ([]byte, map[string]string, error) {
-	// Registering schema and fill metaInfo array.
-	var metaInfo []byte // Meta info contains a magic byte and schema ID (4 bytes).
-	referencePathMap := map[string]string{}
-
-	if len(schemaCfg.SchemaPath) > 0 {
-		srClient, err := c.GetSchemaRegistryClient(cmd)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		id, err := sr.RegisterSchemaWithAuth(cmd, schemaCfg, srClient)
-		if err != nil {
-			return nil, nil, err
-		}
-		metaInfo = sr.GetMetaInfoFromSchemaId(id)
-
-		referencePathMap, err = sr.StoreSchemaReferences(schemaCfg.SchemaDir, schemaCfg.Refs, srClient)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-	return metaInfo, referencePathMap, nil
-}
-
 func PrepareInputChannel(scanErr *error) (chan string, func()) {
 	// Line reader for producer input.
 	scanner := bufio.NewScanner(os.Stdin)
@@ -182,7 +240,51 @@ func PrepareInputChannel(scanErr *error) (chan string, func()) {
 	}
 }
 
+// ValidateInputFormat checks the value of `--input-format`, and that `--parse-key` and `--delimiter` are only set with delimited input.
+func ValidateInputFormat(cmd *cobra.Command) error {
+	inputFormat, err := cmd.Flags().GetString("input-format")
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(pcmd.InputFormats, inputFormat) {
+		return fmt.Errorf("invalid value for `--input-format`: must be %s", utils.ArrayToCommaDelimitedString(pcmd.InputFormats, "or"))
+	}
+
+	if inputFormat != "delimited" {
+		for _, flag := range []string{"parse-key", "delimiter"} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("`--%s` can only be used with `--input-format delimited`", flag)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateKeyFormat(cmd *cobra.Command, parseKey bool) error {
+	inputFormat, err := cmd.Flags().GetString("input-format")
+	if err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("key-format") && !parseKey && inputFormat != "json" {
+		return fmt.Errorf("`--parse-key` or `--input-format json` must be set when `key-format` is set")
+	}
+
+	return nil
+}
+
 func GetProduceMessage(cmd *cobra.Command, keyMetaInfo, valueMetaInfo []byte, topic, data string, keySerializer, valueSerializer serdes.SerializationProvider) (*ckafka.Message, error) {
+	inputFormat, err := cmd.Flags().GetString("input-format")
+	if err != nil {
+		return nil, err
+	}
+
+	if inputFormat == "json" {
+		return getJsonProduceMessage(keyMetaInfo, valueMetaInfo, topic, data, keySerializer, valueSerializer)
+	}
+
 	parseKey, err := cmd.Flags().GetBool("parse-key")
 	if err != nil {
 		return nil, err
@@ -210,6 +312,73 @@ func GetProduceMessage(cmd *cobra.Command, keyMetaInfo, valueMetaInfo []byte, to
 	return message, nil
 }
 
+func getJsonProduceMessage(keyMetaInfo, valueMetaInfo []byte, topic, data string, keySerializer, valueSerializer serdes.SerializationProvider) (*ckafka.Message, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var record produceRecord
+	if err := decoder.Decode(&record); err != nil {
+		return nil, fmt.Errorf(malformedJsonRecordErrorMsg, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf(malformedJsonRecordErrorMsg, fmt.Errorf("unexpected data after JSON object"))
+	}
+
+	key, err := serializeRecordField(keyMetaInfo, record.Key, keySerializer)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := serializeRecordField(valueMetaInfo, record.Value, valueSerializer)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{
+			Topic:     &topic,
+			Partition: ckafka.PartitionAny,
+		},
+		Key:   key,
+		Value: value,
+	}
+
+	if record.Partition != nil {
+		message.TopicPartition.Partition = *record.Partition
+	}
+
+	if record.Timestamp != nil {
+		message.Timestamp = time.UnixMilli(*record.Timestamp)
+	}
+
+	message.Headers = record.Headers
+
+	return message, nil
+}
+
+// serializeRecordField serializes the key or value of a JSON record. A missing or null field produces a null key or value.
+// Formats without a schema take JSON strings as their unquoted contents, and all other JSON values as-is.
+func serializeRecordField(metaInfo []byte, field json.RawMessage, serializer serdes.SerializationProvider) ([]byte, error) {
+	if len(field) == 0 || string(field) == "null" {
+		return nil, nil
+	}
+
+	data := string(field)
+	if serializer.GetSchemaName() == "" {
+		var str string
+		if err := json.Unmarshal(field, &str); err == nil {
+			data = str
+		}
+	}
+
+	serialized, err := serializer.Serialize(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(slices.Clone(metaInfo), serialized...), nil
+}
+
 func serializeMessage(keyMetaInfo, valueMetaInfo []byte, data, delimiter string, parseKey bool, keySerializer, valueSerializer serdes.SerializationProvider) ([]byte, []byte, error) {
 	var serializedKey []byte
 	val := data
@@ -261,6 +430,33 @@ func getKeyAndValue(schemaBased bool, data, delimiter string) (string, string, e
 	return "", "", fmt.Errorf(missingOrMalformedKeyErrorMsg)
 }
 
+func addMessageTypeFlags(cmd *cobra.Command) {
+	cmd.Flags().String("key-message-type", "", "The fully-qualified name of the Protobuf message type of the message key. Defaults to the first message type of the key schema.")
+	cmd.Flags().String("message-type", "", "The fully-qualified name of the Protobuf message type of the message value. Defaults to the first message type of the value schema.")
+}
+
+// setMessageType selects the message type of a Protobuf schema with "--key-message-type" or "--message-type".
+func setMessageType(cmd *cobra.Command, mode string, serializer serdes.SerializationProvider) error {
+	flagName := "message-type"
+	if mode == "key" {
+		flagName = "key-message-type"
+	}
+	messageType, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return err
+	}
+	if messageType == "" {
+		return nil
+	}
+
+	protobufSerializer, ok := serializer.(*serdes.ProtobufSerializationProvider)
+	if !ok {
+		return fmt.Errorf(`"--%s" is only supported for the protobuf format`, flagName)
+	}
+	protobufSerializer.SetMessageType(messageType)
+	return nil
+}
+
 func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (serdes.SerializationProvider, []byte, error) {
 	schemaDir, err := sr.CreateTempDir()
 	if err != nil {
@@ -270,8 +466,6 @@ func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (
 		_ = os.RemoveAll(schemaDir)
 	}()
 
-	subject := topicNameStrategy(topic, mode)
-
 	// Deprecated
 	var schemaId optional.Int32
 	if mode == "value" && cmd.Flags().Changed("schema-id") {
@@ -294,37 +488,63 @@ func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (
 		schemaId = optional.NewInt32(int32(id))
 	}
 
-	var format string
-	referencePathMap := map[string]string{}
-	metaInfo := []byte{}
-
 	if schemaId.IsSet() {
-		srClient, err := c.GetSchemaRegistryClient(cmd)
-		if err != nil {
-			return nil, nil, err
-		}
+		return c.initSchemaWithId(cmd, topic, mode, schemaDir, schemaId.Value())
+	}
 
-		schemaString, err := sr.RequestSchemaWithId(schemaId.Value(), subject, srClient)
-		if err != nil {
-			return nil, nil, err
-		}
+	format, err := cmd.Flags().GetString(fmt.Sprintf("%s-format", mode))
+	if err != nil {
+		return nil, nil, err
+	}
 
-		format, err = serdes.FormatTranslation(schemaString.SchemaType)
-		if err != nil {
-			return nil, nil, err
-		}
+	refs, err := sr.ReadSchemaReferences(cmd, mode == "key")
+	if err != nil {
+		return nil, nil, err
+	}
 
-		schema, referencePathMap, err = sr.SetSchemaPathRef(schemaString, schemaDir, subject, schemaId.Value(), srClient)
-		if err != nil {
-			return nil, nil, err
-		}
+	schemaCfg := &producerSchema{
+		topic:                 topic,
+		mode:                  mode,
+		format:                format,
+		schemaPath:            schema,
+		schemaDir:             schemaDir,
+		refs:                  refs,
+		loadSchemaSuggestions: loadSchemaSuggestions,
+	}
+	return loadProducerSchema(cmd, schemaCfg, func() (*schemaregistry.Client, error) {
+		return c.GetSchemaRegistryClient(cmd)
+	})
+}
 
-		metaInfo = sr.GetMetaInfoFromSchemaId(schemaId.Value())
-	} else {
-		format, err = cmd.Flags().GetString(fmt.Sprintf("%s-format", mode))
-		if err != nil {
-			return nil, nil, err
-		}
+// initSchemaWithId creates the serializer of a message key or value from a schema which is already registered.
+func (c *command) initSchemaWithId(cmd *cobra.Command, topic, mode, schemaDir string, schemaId int32) (serdes.SerializationProvider, []byte, error) {
+	// The subject only sets the context in which to look up the schema ID, so the subject name strategy is not applied.
+	subject, err := getSubjectFlag(cmd, mode)
+	if err != nil {
+		return nil, nil, err
+	}
+	if subject == "" {
+		subject = topicNameStrategy(topic, mode)
+	}
+
+	srClient, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemaString, err := sr.RequestSchemaWithId(schemaId, subject, srClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	format, err := serdes.FormatTranslation(schemaString.SchemaType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemaPath, referencePathMap, err := sr.SetSchemaPathRef(schemaString, schemaDir, subject, schemaId, srClient)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	serializationProvider, err := serdes.GetSerializationProvider(format)
@@ -332,29 +552,13 @@ func (c *command) initSchemaAndGetInfo(cmd *cobra.Command, topic, mode string) (
 		return nil, nil, err
 	}
 
-	if schema != "" && !schemaId.IsSet() {
-		// read schema info from local file and register schema
-		schemaCfg := &sr.RegisterSchemaConfigs{
-			SchemaDir:  schemaDir,
-			SchemaPath: schema,
-			Subject:    subject,
-			Format:     format,
-			SchemaType: serializationProvider.GetSchemaName(),
-		}
-		refs, err := sr.ReadSchemaReferences(cmd, mode == "key")
-		if err != nil {
-			return nil, nil, err
-		}
-		schemaCfg.Refs = refs
-		metaInfo, referencePathMap, err = c.registerSchema(cmd, schemaCfg)
-		if err != nil {
-			return nil, nil, err
-		}
+	if err := setMessageType(cmd, mode, serializationProvider); err != nil {
+		return nil, nil, err
 	}
 
-	if err := serializationProvider.LoadSchema(schema, referencePathMap); err != nil {
-		return nil, nil, errors.NewWrapErrorWithSuggestions(err, "failed to load schema", "Specify a schema by passing a schema ID or the path to a schema file to the `--schema` flag.")
+	if err := serializationProvider.LoadSchema(schemaPath, referencePathMap); err != nil {
+		return nil, nil, errors.NewWrapErrorWithSuggestions(err, "failed to load schema", loadSchemaSuggestions)
 	}
 
-	return serializationProvider, metaInfo, nil
+	return serializationProvider, sr.GetMetaInfoFromSchemaId(schemaId), nil
 }
@@ -3,17 +3,18 @@ package kafka
 import (
 	"fmt"
 	"os"
-	"slices"
 
 	"github.com/spf13/cobra"
 
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
 
 	sr "github.com/confluentinc/cli/v3/internal/schema-registry"
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/examples"
 	"github.com/confluentinc/cli/v3/pkg/log"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
 	"github.com/confluentinc/cli/v3/pkg/serdes"
 )
 
@@ -23,7 +24,7 @@ func (c *command) newProduceCommandOnPrem() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE:  c.produceOnPrem,
 		Short: "Produce messages to a Kafka topic.",
-		Long:  "Produce messages to a Kafka topic. Configuration and command guide: https://docs.confluent.io/confluent-cli/current/cp-produce-consume.html.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.",
+		Long:  "Produce messages to a Kafka topic. Configuration and command guide: https://docs.confluent.io/confluent-cli/current/cp-produce-consume.html.\n\nTo set message headers, partitions, or timestamps, use `--input-format json` and pass one JSON object per line.",
 		Example: examples.BuildExampleString(
 			examples.Example{
 				Text: `Produce message to topic "my_topic" with SASL_SSL/PLAIN protocol (providing username and password).`,
@@ -44,8 +45,11 @@ func (c *command) newProduceCommandOnPrem() *cobra.Command {
 	pcmd.AddKeyFormatFlag(cmd)
 	pcmd.AddValueFormatFlag(cmd)
 	cmd.Flags().String("references", "", "The path to the references file.")
+	addMessageTypeFlags(cmd)
+	addSubjectNameStrategyFlags(cmd)
 	cmd.Flags().Bool("parse-key", false, "Parse key from the message.")
 	cmd.Flags().String("delimiter", ":", "The delimiter separating each key and value.")
+	pcmd.AddInputFormatFlag(cmd)
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
 	pcmd.AddProducerConfigFileFlag(cmd)
 	cmd.Flags().String("schema-registry-endpoint", "", "The URL of the Schema Registry cluster.")
@@ -63,6 +67,10 @@ func (c *command) newProduceCommandOnPrem() *cobra.Command {
 }
 
 func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
+	if err := ValidateInputFormat(cmd); err != nil {
+		return err
+	}
+
 	configFile, err := cmd.Flags().GetString("config-file")
 	if err != nil {
 		return err
@@ -82,7 +90,7 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 	defer producer.Close()
 	log.CliLogger.Tracef("Create producer succeeded")
 
-	if err := c.refreshOAuthBearerToken(cmd, producer); err != nil {
+	if err := refreshOAuthBearerToken(cmd, producer, c.Context); err != nil {
 		return err
 	}
 
@@ -97,32 +105,12 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	keyFormat, keySubject, keySerializer, err := prepareSerializer(cmd, topic, "key")
-	if err != nil {
-		return err
-	}
-
-	valueFormat, valueSubject, valueSerializer, err := prepareSerializer(cmd, topic, "value")
-	if err != nil {
-		return err
-	}
-
 	parseKey, err := cmd.Flags().GetBool("parse-key")
 	if err != nil {
 		return err
 	}
 
-	if cmd.Flags().Changed("key-format") && !parseKey {
-		return fmt.Errorf("`--parse-key` must be set when `key-format` is set")
-	}
-
-	keySchema, err := cmd.Flags().GetString("key-schema")
-	if err != nil {
-		return err
-	}
-
-	schema, err := cmd.Flags().GetString("schema")
-	if err != nil {
+	if err := validateKeyFormat(cmd, parseKey); err != nil {
 		return err
 	}
 
@@ -139,81 +127,46 @@ func (c *command) produceOnPrem(cmd *cobra.Command, args []string) error {
 		_ = os.RemoveAll(dir)
 	}()
 
-	keySchemaConfigs := &sr.RegisterSchemaConfigs{
-		Subject:    keySubject,
-		SchemaDir:  dir,
-		SchemaType: keySerializer.GetSchemaName(),
-		Format:     keyFormat,
-		SchemaPath: keySchema,
-		Refs:       refs,
-	}
-	keyMetaInfo, keyReferencePathMap, err := c.registerSchemaOnPrem(cmd, keySchemaConfigs)
+	keySerializer, keyMetaInfo, err := c.initSchemaOnPrem(cmd, topic, "key", dir, refs)
 	if err != nil {
 		return err
 	}
-	if err := keySerializer.LoadSchema(keySchema, keyReferencePathMap); err != nil {
-		return err
-	}
 
-	valueSchemaConfigs := &sr.RegisterSchemaConfigs{
-		Subject:    valueSubject,
-		SchemaDir:  dir,
-		SchemaType: valueSerializer.GetSchemaName(),
-		Format:     valueFormat,
-		SchemaPath: schema,
-		Refs:       refs,
-	}
-	valueMetaInfo, referencePathMap, err := c.registerSchemaOnPrem(cmd, valueSchemaConfigs)
+	valueSerializer, valueMetaInfo, err := c.initSchemaOnPrem(cmd, topic, "value", dir, refs)
 	if err != nil {
 		return err
 	}
-	if err := valueSerializer.LoadSchema(schema, referencePathMap); err != nil {
-		return err
-	}
 
 	return ProduceToTopic(cmd, keyMetaInfo, valueMetaInfo, topic, keySerializer, valueSerializer, producer)
 }
 
-func prepareSerializer(cmd *cobra.Command, topic, mode string) (string, string, serdes.SerializationProvider, error) {
-	valueFormat, err := cmd.Flags().GetString(fmt.Sprintf("%s-format", mode))
+func (c *command) initSchemaOnPrem(cmd *cobra.Command, topic, mode, dir string, refs []srsdk.SchemaReference) (serdes.SerializationProvider, []byte, error) {
+	format, err := cmd.Flags().GetString(fmt.Sprintf("%s-format", mode))
 	if err != nil {
-		return "", "", nil, err
+		return nil, nil, err
 	}
 
-	serializer, err := serdes.GetSerializationProvider(valueFormat)
+	schemaFlagName := "schema"
+	if mode == "key" {
+		schemaFlagName = "key-schema"
+	}
+	schema, err := cmd.Flags().GetString(schemaFlagName)
 	if err != nil {
-		return "", "", nil, err
+		return nil, nil, err
 	}
 
-	return valueFormat, topicNameStrategy(topic, mode), serializer, nil
-}
-
-func (c *command) registerSchemaOnPrem(cmd *cobra.Command, schemaCfg *sr.RegisterSchemaConfigs) ([]byte, map[string]string, error) {
-	// For plain string encoding, meta info is empty.
-	// Registering schema when specified, and fill metaInfo array.
-	metaInfo := []byte{}
-	referencePathMap := map[string]string{}
-	if slices.Contains(serdes.SchemaBasedFormats, schemaCfg.Format) && schemaCfg.SchemaPath != "" {
+	schemaCfg := &producerSchema{
+		topic:      topic,
+		mode:       mode,
+		format:     format,
+		schemaPath: schema,
+		schemaDir:  dir,
+		refs:       refs,
+	}
+	return loadProducerSchema(cmd, schemaCfg, func() (*schemaregistry.Client, error) {
 		if c.Context.State == nil { // require log-in to use oauthbearer token
-			return nil, nil, errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
-		}
-
-		srClient, err := c.GetSchemaRegistryClient(cmd)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		id, err := sr.RegisterSchemaWithAuth(cmd, schemaCfg, srClient)
-		if err != nil {
-			return nil, nil, err
+			return nil, errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
 		}
-		metaInfo = sr.GetMetaInfoFromSchemaId(id)
-
-		referencePathMap, err = sr.StoreSchemaReferences(schemaCfg.SchemaDir, schemaCfg.Refs, srClient)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	return metaInfo, referencePathMap, nil
+		return c.GetSchemaRegistryClient(cmd)
+	})
 }
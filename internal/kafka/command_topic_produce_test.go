@@ -3,7 +3,14 @@ package kafka
 import (
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
 )
 
 type splitTest struct {
@@ -94,3 +101,102 @@ func TestGetKeyAndValue_Fail(t *testing.T) {
 		assert.Equal(t, err.Error(), missingKeyOrValueErrorMsg)
 	}
 }
+
+func TestGetJsonProduceMessage(t *testing.T) {
+	keySerializer, err := serdes.GetSerializationProvider("string")
+	require.NoError(t, err)
+	valueSerializer, err := serdes.GetSerializationProvider("integer")
+	require.NoError(t, err)
+
+	data := `{"key": "my-key", "value": 42, "headers": {"b": "2", "a": "1", "c": null}, "partition": 3, "timestamp": 1700000000000}`
+	message, err := getJsonProduceMessage([]byte{}, []byte{}, "topic", data, keySerializer, valueSerializer)
+	require.NoError(t, err)
+
+	require.Equal(t, "topic", *message.TopicPartition.Topic)
+	require.Equal(t, int32(3), message.TopicPartition.Partition)
+	require.Equal(t, []byte("my-key"), message.Key)
+	require.Equal(t, []byte{42, 0, 0, 0}, message.Value)
+	require.Equal(t, int64(1700000000000), message.Timestamp.UnixMilli())
+	require.Equal(t, []ckafka.Header{{Key: "b", Value: []byte("2")}, {Key: "a", Value: []byte("1")}, {Key: "c"}}, message.Headers)
+}
+
+func TestGetJsonProduceMessage_Headers(t *testing.T) {
+	serializer, err := serdes.GetSerializationProvider("string")
+	require.NoError(t, err)
+
+	expected := []ckafka.Header{{Key: "b", Value: []byte("2")}, {Key: "a", Value: []byte("1")}, {Key: "b", Value: []byte("3")}, {Key: "c"}}
+	for _, data := range []string{
+		`{"value": "v", "headers": {"b": "2", "a": "1", "b": "3", "c": null}}`,
+		`{"value": "v", "headers": [{"key": "b", "value": "2"}, {"key": "a", "value": "1"}, {"key": "b", "value": "3"}, {"key": "c", "value": null}]}`,
+	} {
+		message, err := getJsonProduceMessage([]byte{}, []byte{}, "topic", data, serializer, serializer)
+		require.NoError(t, err, data)
+		require.Equal(t, expected, message.Headers, data)
+	}
+}
+
+func TestGetJsonProduceMessage_Null(t *testing.T) {
+	serializer, err := serdes.GetSerializationProvider("string")
+	require.NoError(t, err)
+
+	message, err := getJsonProduceMessage([]byte{}, []byte{}, "topic", `{"value": null}`, serializer, serializer)
+	require.NoError(t, err)
+
+	require.Equal(t, ckafka.PartitionAny, message.TopicPartition.Partition)
+	require.Nil(t, message.Key)
+	require.Nil(t, message.Value)
+	require.Empty(t, message.Headers)
+}
+
+func TestGetJsonProduceMessage_Fail(t *testing.T) {
+	serializer, err := serdes.GetSerializationProvider("string")
+	require.NoError(t, err)
+
+	for _, data := range []string{`key:value`, `{"value": "v", "unknown": 1}`, `{"value": "v"} extra`, `{"partition": "one"}`, `{"headers": "h"}`, `{"headers": {"a": 1}}`} {
+		_, err := getJsonProduceMessage([]byte{}, []byte{}, "topic", data, serializer, serializer)
+		require.Error(t, err, data)
+	}
+}
+
+func TestValidateInputFormat(t *testing.T) {
+	tests := []struct {
+		args []string
+		err  string
+	}{
+		{args: []string{}},
+		{args: []string{"--input-format", "delimited", "--delimiter", "|", "--parse-key"}},
+		{args: []string{"--input-format", "json"}},
+		{args: []string{"--input-format", "xml"}, err: "invalid value for `--input-format`: must be \"delimited\" or \"json\""},
+		{args: []string{"--input-format", "json", "--parse-key"}, err: "`--parse-key` can only be used with `--input-format delimited`"},
+		{args: []string{"--input-format", "json", "--delimiter", "|"}, err: "`--delimiter` can only be used with `--input-format delimited`"},
+	}
+
+	for _, test := range tests {
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("parse-key", false, "")
+		cmd.Flags().String("delimiter", ":", "")
+		pcmd.AddInputFormatFlag(cmd)
+		require.NoError(t, cmd.ParseFlags(test.args))
+
+		err := ValidateInputFormat(cmd)
+		if test.err == "" {
+			require.NoError(t, err, test.args)
+		} else {
+			require.EqualError(t, err, test.err, test.args)
+		}
+	}
+}
+
+func TestGetJsonProduceMessage_ConsumedMessage(t *testing.T) {
+	serializer, err := serdes.GetSerializationProvider("string")
+	require.NoError(t, err)
+
+	data := `{"topic":"other-topic","partition":1,"offset":2,"timestamp":868060800000,"key":"key","value":"value","headers":[{"key":"a","value":"1"}],"value_schema_id":100001}`
+	message, err := getJsonProduceMessage([]byte{}, []byte{}, "topic", data, serializer, serializer)
+	require.NoError(t, err)
+
+	require.Equal(t, "topic", *message.TopicPartition.Topic)
+	require.Equal(t, int32(1), message.TopicPartition.Partition)
+	require.Equal(t, []byte("value"), message.Value)
+	require.Equal(t, []ckafka.Header{{Key: "a", Value: []byte("1")}}, message.Headers)
+}
@@ -135,6 +135,7 @@ func (c *command) update(cmd *cobra.Command, args []string) error {
 
 	var readOnlyConfigNotUpdatedString string
 	list := output.NewList(cmd)
+	list.Columns(new(topicConfigurationOut))
 	for _, config := range kafkaRestConfigs {
 		list.Add(&topicConfigurationOut{
 			Name:     config.Name,
@@ -86,9 +86,12 @@ func UpdateTopic(cmd *cobra.Command, restClient *kafkarestv3.APIClient, restCont
 		return output.SerializedOutput(cmd, data)
 	}
 
-	output.Printf(false, "Updated the following configuration values for topic \"%s\":\n", topicName)
+	if output.GetFormat(cmd) == output.Human {
+		output.Printf(false, "Updated the following configuration values for topic \"%s\":\n", topicName)
+	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(broker.ConfigOut))
 	for _, config := range data {
 		list.Add(&broker.ConfigOut{
 			Name:  config.Name,
@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -21,6 +22,7 @@ import (
 
 	sr "github.com/confluentinc/cli/v3/internal/schema-registry"
 	"github.com/confluentinc/cli/v3/pkg/config"
+	dynamicconfig "github.com/confluentinc/cli/v3/pkg/dynamic-config"
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/log"
 	"github.com/confluentinc/cli/v3/pkg/output"
@@ -32,6 +34,8 @@ import (
 const (
 	messageOffset = 5 // Schema ID is stored at the [1:5] bytes of a message as meta info (when valid)
 
+	offsetsForTimesTimeoutMs = 10000
+
 	// required fields of SASL/oauthbearer configuration
 	principalClaimNameKey = "principalClaimName"
 	principalKey          = "principal"
@@ -53,6 +57,33 @@ type ConsumerProperties struct {
 	PrintOffset bool
 	Timestamp   bool
 	SchemaPath  string
+	Output      output.Format
+}
+
+// consumedMessage is a message as printed by `kafka topic consume --output json`.
+// It can be produced again with `kafka topic produce --input-format json`, which ignores its metadata.
+type consumedMessage struct {
+	Topic         string          `json:"topic"`
+	Partition     int32           `json:"partition"`
+	Offset        int64           `json:"offset"`
+	Timestamp     int64           `json:"timestamp"`
+	Key           json.RawMessage `json:"key"`
+	Value         json.RawMessage `json:"value"`
+	Headers       []produceHeader `json:"headers,omitempty"`
+	KeySchemaId   int32           `json:"key_schema_id,omitempty"`
+	ValueSchemaId int32           `json:"value_schema_id,omitempty"`
+}
+
+// ConsumerBounds describe when a consumer stops on its own, rather than running until interrupted.
+type ConsumerBounds struct {
+	MaxMessages int
+	UntilOffset *int64
+	ExitOnEOF   bool
+	Timeout     time.Duration
+}
+
+func (b ConsumerBounds) isSet() bool {
+	return b.MaxMessages > 0 || b.UntilOffset != nil || b.ExitOnEOF
 }
 
 // GroupHandler instances are used to handle individual topic-partition claims.
@@ -63,9 +94,12 @@ type GroupHandler struct {
 	Out         io.Writer
 	Subject     string
 	Properties  ConsumerProperties
+	Bounds      ConsumerBounds
+	ErrorPolicy ErrorPolicy
+	Archive     *TopicArchive
 }
 
-func (c *command) refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Handle) error {
+func refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Handle, ctx *dynamicconfig.DynamicContext) error {
 	protocol, err := cmd.Flags().GetString("protocol")
 	if err != nil {
 		return err
@@ -76,10 +110,10 @@ func (c *command) refreshOAuthBearerToken(cmd *cobra.Command, client ckafka.Hand
 	}
 	if protocol == "SASL_SSL" && saslMechanism == "OAUTHBEARER" {
 		oart := ckafka.OAuthBearerTokenRefresh{Config: oauthConfig}
-		if c.Context.State == nil { // require log-in to use oauthbearer token
+		if ctx.State == nil { // require log-in to use oauthbearer token
 			return errors.NewErrorWithSuggestions(errors.NotLoggedInErrorMsg, errors.AuthTokenSuggestions)
 		}
-		oauthBearerToken, retrieveErr := retrieveUnsecuredToken(oart, c.Context.GetAuthToken())
+		oauthBearerToken, retrieveErr := retrieveUnsecuredToken(oart, ctx.GetAuthToken())
 		if retrieveErr != nil {
 			_ = client.SetOAuthBearerTokenFailure(retrieveErr.Error())
 			return fmt.Errorf("token retrieval error: %w", retrieveErr)
@@ -162,15 +196,34 @@ func newOnPremConsumer(cmd *cobra.Command, clientID, configPath string, configSt
 
 // example: https://github.com/confluentinc/confluent-kafka-go/blob/e01dd295220b5bf55f3fbfabdf8cc6d3f0ae185f/examples/cooperative_consumer_example/cooperative_consumer_example.go#L121
 func GetRebalanceCallback(offset ckafka.Offset, partitionFilter PartitionFilter) func(*ckafka.Consumer, ckafka.Event) error {
+	return getRebalanceCallback(func(_ *ckafka.Consumer, partitions []ckafka.TopicPartition) ([]ckafka.TopicPartition, error) {
+		for i := range partitions {
+			partitions[i].Offset = offset
+		}
+		return partitions, nil
+	}, partitionFilter)
+}
+
+// GetTimestampRebalanceCallback starts each assigned partition at the earliest offset whose timestamp is at or after
+// the given timestamp, in milliseconds.
+func GetTimestampRebalanceCallback(timestamp int64, partitionFilter PartitionFilter) func(*ckafka.Consumer, ckafka.Event) error {
+	return getRebalanceCallback(func(consumer *ckafka.Consumer, partitions []ckafka.TopicPartition) ([]ckafka.TopicPartition, error) {
+		for i := range partitions {
+			partitions[i].Offset = ckafka.Offset(timestamp)
+		}
+		return consumer.OffsetsForTimes(partitions, offsetsForTimesTimeoutMs)
+	}, partitionFilter)
+}
+
+func getRebalanceCallback(setOffsets func(*ckafka.Consumer, []ckafka.TopicPartition) ([]ckafka.TopicPartition, error), partitionFilter PartitionFilter) func(*ckafka.Consumer, ckafka.Event) error {
 	return func(consumer *ckafka.Consumer, event ckafka.Event) error {
 		switch ev := event.(type) { // ev is of type ckafka.Event
 		case ckafka.AssignedPartitions:
-			partitions := make([]ckafka.TopicPartition, len(ev.Partitions))
-			for i, partition := range ev.Partitions {
-				partition.Offset = offset
-				partitions[i] = partition
+			partitions := getPartitionsByIndex(slices.Clone(ev.Partitions), partitionFilter)
+			partitions, err := setOffsets(consumer, partitions)
+			if err != nil {
+				return err
 			}
-			partitions = getPartitionsByIndex(partitions, partitionFilter)
 
 			if err := consumer.IncrementalAssign(partitions); err != nil {
 				return err
@@ -188,25 +241,36 @@ func GetRebalanceCallback(offset ckafka.Offset, partitionFilter PartitionFilter)
 	}
 }
 
-func consumeMessage(message *ckafka.Message, h *GroupHandler) error {
-	if h.Properties.PrintKey {
-		keyDeserializer, err := serdes.GetDeserializationProvider(h.KeyFormat)
-		if err != nil {
+// handleMessage prints a message once it is fully deserialized, so that nothing is printed for a message which fails
+// to deserialize. Such a message stops the consumer, unless the error policy handles it.
+func (h *GroupHandler) handleMessage(message *ckafka.Message) error {
+	// Deserializing may strip the schema ID from the message, so the original is kept for the error policy.
+	consumed := *message
+
+	buf := new(bytes.Buffer)
+	if err := consumeMessage(buf, &consumed, h); err != nil {
+		if h.ErrorPolicy.stopsConsumer() {
 			return err
 		}
+		return h.ErrorPolicy.handle(h.Out, message, err, h.Properties.Output)
+	}
 
-		if slices.Contains(serdes.SchemaBasedFormats, h.KeyFormat) {
-			schemaPath, referencePathMap, err := h.RequestSchema(message.Key)
-			if err != nil {
-				return err
-			}
-			message.Key = message.Key[messageOffset:]
-			if err := keyDeserializer.LoadSchema(schemaPath, referencePathMap); err != nil {
-				return err
-			}
-		}
+	_, err := h.Out.Write(buf.Bytes())
+	return err
+}
 
-		jsonMessage, err := keyDeserializer.Deserialize(message.Key)
+func consumeMessage(out io.Writer, message *ckafka.Message, h *GroupHandler) error {
+	if h.Archive != nil {
+		h.Archive.addMessage(message)
+		return nil
+	}
+
+	if h.Properties.Output == output.JSON {
+		return consumeMessageAsJson(out, message, h)
+	}
+
+	if h.Properties.PrintKey {
+		jsonMessage, _, err := h.deserialize(message.Key, h.KeyFormat)
 		if err != nil {
 			return err
 		}
@@ -214,7 +278,7 @@ func consumeMessage(message *ckafka.Message, h *GroupHandler) error {
 			jsonMessage = "null"
 		}
 
-		if _, err := fmt.Fprint(h.Out, jsonMessage+h.Properties.Delimiter); err != nil {
+		if _, err := fmt.Fprint(out, jsonMessage+h.Properties.Delimiter); err != nil {
 			return err
 		}
 	}
@@ -240,7 +304,7 @@ func consumeMessage(message *ckafka.Message, h *GroupHandler) error {
 	if err != nil {
 		return err
 	}
-	if _, err := fmt.Fprintln(h.Out, messageString); err != nil {
+	if _, err := fmt.Fprintln(out, messageString); err != nil {
 		return err
 	}
 
@@ -249,7 +313,7 @@ func consumeMessage(message *ckafka.Message, h *GroupHandler) error {
 		if h.Properties.FullHeader {
 			headers = getFullHeaders(message.Headers)
 		}
-		if _, err := fmt.Fprintf(h.Out, "%% Headers: %v\n", headers); err != nil {
+		if _, err := fmt.Fprintf(out, "%% Headers: %v\n", headers); err != nil {
 			return err
 		}
 	}
@@ -257,6 +321,92 @@ func consumeMessage(message *ckafka.Message, h *GroupHandler) error {
 	return nil
 }
 
+func consumeMessageAsJson(out io.Writer, message *ckafka.Message, h *GroupHandler) error {
+	record := &consumedMessage{
+		Partition: message.TopicPartition.Partition,
+		Offset:    int64(message.TopicPartition.Offset),
+		Timestamp: message.Timestamp.UnixMilli(),
+	}
+	if message.TopicPartition.Topic != nil {
+		record.Topic = *message.TopicPartition.Topic
+	}
+
+	if message.Key != nil {
+		key, schemaId, err := h.deserialize(message.Key, h.KeyFormat)
+		if err != nil {
+			return err
+		}
+		record.KeySchemaId = schemaId
+		if record.Key, err = toJsonField(key, h.KeyFormat); err != nil {
+			return err
+		}
+	}
+
+	if message.Value != nil {
+		value, schemaId, err := h.deserialize(message.Value, h.ValueFormat)
+		if err != nil {
+			return err
+		}
+		record.ValueSchemaId = schemaId
+		if record.Value, err = toJsonField(value, h.ValueFormat); err != nil {
+			return err
+		}
+	}
+
+	for _, header := range message.Headers {
+		var value *string
+		if header.Value != nil {
+			str := string(header.Value)
+			value = &str
+		}
+		record.Headers = append(record.Headers, produceHeader{Key: header.Key, Value: value})
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(out, string(data))
+	return err
+}
+
+// deserialize decodes a message key or value, returning it along with the ID of its schema, if any.
+func (h *GroupHandler) deserialize(data []byte, format string) (string, int32, error) {
+	deserializer, err := serdes.GetDeserializationProvider(format)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var schemaId int32
+	if slices.Contains(serdes.SchemaBasedFormats, format) {
+		schemaPath, referencePathMap, err := h.RequestSchema(data)
+		if err != nil {
+			return "", 0, err
+		}
+		schemaId = int32(binary.BigEndian.Uint32(data[1:messageOffset]))
+		data = data[messageOffset:]
+		if err := deserializer.LoadSchema(schemaPath, referencePathMap); err != nil {
+			return "", 0, err
+		}
+	}
+
+	str, err := deserializer.Deserialize(data)
+	return str, schemaId, err
+}
+
+// toJsonField embeds a deserialized key or value in a JSON message. Strings are quoted, while other formats are
+// already valid JSON and are only compacted onto a single line.
+func toJsonField(str, format string) (json.RawMessage, error) {
+	if format != "string" && json.Valid([]byte(str)) {
+		buf := new(bytes.Buffer)
+		if err := json.Compact(buf, []byte(str)); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	return json.Marshal(str)
+}
+
 func getMessageString(message *ckafka.Message, valueDeserializer serdes.DeserializationProvider, properties ConsumerProperties) (string, error) {
 	messageString, err := valueDeserializer.Deserialize(message.Value)
 	if err != nil {
@@ -279,6 +429,13 @@ func getMessageString(message *ckafka.Message, valueDeserializer serdes.Deserial
 }
 
 func RunConsumer(consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
+	tracker := newBoundTracker(groupHandler.Bounds)
+
+	var timeout <-chan time.Time
+	if groupHandler.Bounds.Timeout > 0 {
+		timeout = time.After(groupHandler.Bounds.Timeout)
+	}
+
 	run := true
 	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, os.Interrupt)
@@ -286,11 +443,11 @@ func RunConsumer(consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
 		select {
 		case <-signals: // Trap SIGINT to trigger a shutdown.
 			output.ErrPrintln(false, "Stopping Consumer.")
-			if _, err := consumer.Commit(); err != nil {
-				log.CliLogger.Warnf("Failed to commit current consumer offset: %v", err)
-			}
-			consumer.Close()
+			stopConsumer(consumer)
 			run = false
+		case <-timeout:
+			stopConsumer(consumer)
+			return fmt.Errorf("consumer timed out after %s before reaching its bound (%d message(s) consumed)", groupHandler.Bounds.Timeout, tracker.count)
 		default:
 			event := consumer.Poll(100) // polling event from consumer with a timeout of 100ms
 			if event == nil {
@@ -298,7 +455,11 @@ func RunConsumer(consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
 			}
 			switch e := event.(type) {
 			case *ckafka.Message:
-				if err := consumeMessage(e, groupHandler); err != nil {
+				if tracker.skip(e.TopicPartition) {
+					if err := consumer.Pause([]ckafka.TopicPartition{e.TopicPartition}); err != nil {
+						log.CliLogger.Warnf("Failed to pause partition %d: %v", e.TopicPartition.Partition, err)
+					}
+				} else if err := groupHandler.handleMessage(e); err != nil {
 					commitErrCh := make(chan error, 1)
 					go func() {
 						_, err := consumer.Commit()
@@ -315,10 +476,33 @@ func RunConsumer(consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
 					}
 
 					return err
+				} else {
+					tracker.consume(e.TopicPartition)
 				}
+			case ckafka.PartitionEOF:
+				tracker.eof(ckafka.TopicPartition(e))
 			case ckafka.Error:
 				fmt.Fprintf(groupHandler.Out, "%% Error: %v: %v\n", e.Code(), e)
 				if e.Code() == ckafka.ErrAllBrokersDown {
+					// A bounded consumer could not reach its bound, so it fails rather than exiting as if it had.
+					if groupHandler.Bounds.isSet() || groupHandler.Bounds.Timeout > 0 {
+						stopConsumer(consumer)
+						return fmt.Errorf("failed to consume: all brokers are down")
+					}
+					run = false
+				}
+				continue
+			default:
+				continue
+			}
+
+			if groupHandler.Bounds.isSet() {
+				assignment, err := consumer.Assignment()
+				if err != nil {
+					return err
+				}
+				if tracker.done(assignment) {
+					stopConsumer(consumer)
 					run = false
 				}
 			}
@@ -327,6 +511,70 @@ func RunConsumer(consumer *ckafka.Consumer, groupHandler *GroupHandler) error {
 	return nil
 }
 
+func stopConsumer(consumer *ckafka.Consumer) {
+	if _, err := consumer.Commit(); err != nil {
+		log.CliLogger.Warnf("Failed to commit current consumer offset: %v", err)
+	}
+	consumer.Close()
+}
+
+// boundTracker records a consumer's progress towards its bounds.
+type boundTracker struct {
+	bounds   ConsumerBounds
+	count    int
+	finished map[int32]bool
+}
+
+func newBoundTracker(bounds ConsumerBounds) *boundTracker {
+	return &boundTracker{
+		bounds:   bounds,
+		finished: make(map[int32]bool),
+	}
+}
+
+// skip returns true if a message is at or beyond `--until-offset`, in which case its partition is finished.
+func (t *boundTracker) skip(partition ckafka.TopicPartition) bool {
+	if t.bounds.UntilOffset != nil && int64(partition.Offset) >= *t.bounds.UntilOffset {
+		t.finished[partition.Partition] = true
+		return true
+	}
+	return false
+}
+
+func (t *boundTracker) consume(partition ckafka.TopicPartition) {
+	t.count++
+	if t.bounds.UntilOffset != nil && int64(partition.Offset)+1 >= *t.bounds.UntilOffset {
+		t.finished[partition.Partition] = true
+	}
+}
+
+// eof marks a partition as finished when consuming with `--exit-on-eof`, or with `--until-offset` beyond the end of the
+// partition, which would otherwise never be reached.
+func (t *boundTracker) eof(partition ckafka.TopicPartition) {
+	if t.bounds.ExitOnEOF || t.bounds.UntilOffset != nil {
+		t.finished[partition.Partition] = true
+	}
+}
+
+// done returns true once `--max-messages` messages have been consumed, or every assigned partition has reached
+// `--until-offset` or its end.
+func (t *boundTracker) done(assignment []ckafka.TopicPartition) bool {
+	if t.bounds.MaxMessages > 0 && t.count >= t.bounds.MaxMessages {
+		return true
+	}
+
+	if (t.bounds.UntilOffset == nil && !t.bounds.ExitOnEOF) || len(assignment) == 0 {
+		return false
+	}
+
+	for _, partition := range assignment {
+		if !t.finished[partition.Partition] {
+			return false
+		}
+	}
+	return true
+}
+
 func (h *GroupHandler) RequestSchema(value []byte) (string, map[string]string, error) {
 	if len(value) == 0 || value[0] != 0x0 {
 		return "", nil, errors.NewErrorWithSuggestions("unknown magic byte", fmt.Sprintf("Check that all messages from this topic are in the %s format.", h.ValueFormat))
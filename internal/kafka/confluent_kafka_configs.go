@@ -80,6 +80,11 @@ func getConsumerConfigMap(group string, kafka *config.KafkaClusterConfig, client
 	if err := configMap.SetKey("partition.assignment.strategy", "cooperative-sticky"); err != nil {
 		return nil, err
 	}
+
+	// Emit an event upon reaching the end of a partition, for `--exit-on-eof`.
+	if err := configMap.SetKey("enable.partition.eof", true); err != nil {
+		return nil, err
+	}
 	if err := SetConsumerDebugOption(configMap); err != nil {
 		return nil, err
 	}
@@ -160,9 +165,13 @@ func getOnPremConsumerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.Co
 		}
 	}
 
-	group, err := cmd.Flags().GetString("group")
-	if err != nil {
-		return nil, err
+	// Commands such as `kafka topic export` have no "--group" flag, and always use a new group.
+	var group string
+	if cmd.Flags().Changed("group") {
+		group, err = cmd.Flags().GetString("group")
+		if err != nil {
+			return nil, err
+		}
 	}
 	if group == "" {
 		group = fmt.Sprintf("confluent_cli_consumer_%s", uuid.New())
@@ -177,6 +186,11 @@ func getOnPremConsumerConfigMap(cmd *cobra.Command, clientID string) (*ckafka.Co
 		return nil, err
 	}
 
+	// Emit an event upon reaching the end of a partition, for `--exit-on-eof`.
+	if err := configMap.SetKey("enable.partition.eof", true); err != nil {
+		return nil, err
+	}
+
 	if err := SetConsumerDebugOption(configMap); err != nil {
 		return nil, err
 	}
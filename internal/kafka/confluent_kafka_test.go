@@ -1,6 +1,7 @@
 package kafka
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 
 	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
 
+	"github.com/confluentinc/cli/v3/pkg/output"
 	"github.com/confluentinc/cli/v3/pkg/serdes"
 )
 
@@ -24,3 +26,99 @@ func TestGetMessageString(t *testing.T) {
 	expected := "Timestamp:868060800000 Partition:1 Offset:2	message"
 	require.Equal(t, expected, actual)
 }
+
+func TestConsumeMessageAsJson(t *testing.T) {
+	topic := "topic"
+	message := &ckafka.Message{
+		Key:            []byte("key"),
+		Value:          []byte{42, 0, 0, 0},
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Offset: 2, Partition: 1},
+		Timestamp:      time.Date(1997, time.July, 5, 0, 0, 0, 0, time.UTC),
+		Headers:        []ckafka.Header{{Key: "a", Value: []byte("1")}, {Key: "b"}, {Key: "a", Value: []byte("2")}},
+	}
+
+	buf := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat:   "string",
+		ValueFormat: "integer",
+		Out:         buf,
+		Properties:  ConsumerProperties{Output: output.JSON},
+	}
+	require.NoError(t, consumeMessage(h.Out, message, h))
+
+	expected := `{"topic":"topic","partition":1,"offset":2,"timestamp":868060800000,"key":"key","value":42,"headers":[{"key":"a","value":"1"},{"key":"b","value":null},{"key":"a","value":"2"}]}` + "\n"
+	require.Equal(t, expected, buf.String())
+}
+
+func TestConsumeMessageAsJson_Tombstone(t *testing.T) {
+	message := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Offset: 0, Partition: 0},
+		Timestamp:      time.UnixMilli(0),
+	}
+
+	buf := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat:   "string",
+		ValueFormat: "string",
+		Out:         buf,
+		Properties:  ConsumerProperties{Output: output.JSON},
+	}
+	require.NoError(t, consumeMessage(h.Out, message, h))
+
+	require.Equal(t, `{"topic":"","partition":0,"offset":0,"timestamp":0,"key":null,"value":null}`+"\n", buf.String())
+}
+
+func TestBoundTracker_MaxMessages(t *testing.T) {
+	tracker := newBoundTracker(ConsumerBounds{MaxMessages: 2})
+	assignment := []ckafka.TopicPartition{{Partition: 0}}
+
+	tracker.consume(ckafka.TopicPartition{Partition: 0, Offset: 0})
+	require.False(t, tracker.done(assignment))
+	tracker.consume(ckafka.TopicPartition{Partition: 0, Offset: 1})
+	require.True(t, tracker.done(assignment))
+}
+
+func TestBoundTracker_UntilOffset(t *testing.T) {
+	untilOffset := int64(2)
+	tracker := newBoundTracker(ConsumerBounds{UntilOffset: &untilOffset})
+	assignment := []ckafka.TopicPartition{{Partition: 0}, {Partition: 1}}
+
+	require.False(t, tracker.skip(ckafka.TopicPartition{Partition: 0, Offset: 1}))
+	tracker.consume(ckafka.TopicPartition{Partition: 0, Offset: 1})
+	require.False(t, tracker.done(assignment))
+
+	require.True(t, tracker.skip(ckafka.TopicPartition{Partition: 1, Offset: 5}))
+	require.True(t, tracker.done(assignment))
+}
+
+func TestBoundTracker_ExitOnEOF(t *testing.T) {
+	tracker := newBoundTracker(ConsumerBounds{ExitOnEOF: true})
+	assignment := []ckafka.TopicPartition{{Partition: 0}, {Partition: 1}}
+
+	require.False(t, tracker.done(nil))
+	tracker.eof(ckafka.TopicPartition{Partition: 0})
+	require.False(t, tracker.done(assignment))
+	tracker.eof(ckafka.TopicPartition{Partition: 1})
+	require.True(t, tracker.done(assignment))
+}
+
+func TestBoundTracker_UntilOffsetEOF(t *testing.T) {
+	untilOffset := int64(10)
+	tracker := newBoundTracker(ConsumerBounds{UntilOffset: &untilOffset})
+	assignment := []ckafka.TopicPartition{{Partition: 0}, {Partition: 1}}
+
+	tracker.consume(ckafka.TopicPartition{Partition: 0, Offset: 2})
+	tracker.eof(ckafka.TopicPartition{Partition: 0, Offset: 3})
+	require.False(t, tracker.done(assignment))
+	tracker.eof(ckafka.TopicPartition{Partition: 1})
+	require.True(t, tracker.done(assignment))
+}
+
+func TestBoundTracker_Unbounded(t *testing.T) {
+	tracker := newBoundTracker(ConsumerBounds{})
+	assignment := []ckafka.TopicPartition{{Partition: 0}}
+
+	tracker.eof(ckafka.TopicPartition{Partition: 0})
+	require.False(t, tracker.skip(ckafka.TopicPartition{Partition: 0, Offset: 100}))
+	require.False(t, tracker.done(assignment))
+}
@@ -0,0 +1,254 @@
+package kafka
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const (
+	onErrorFail     = "fail"
+	onErrorSkip     = "skip"
+	onErrorPrintRaw = "print-raw"
+	onErrorDLQ      = "dlq"
+
+	deadLetterErrorHeader     = "cli.error"
+	deadLetterTopicHeader     = "cli.source.topic"
+	deadLetterPartitionHeader = "cli.source.partition"
+	deadLetterOffsetHeader    = "cli.source.offset"
+)
+
+var (
+	onErrorActions = []string{onErrorSkip, onErrorPrintRaw, onErrorFail, onErrorDLQ + "="}
+	rawEncodings   = []string{"base64", "hex"}
+)
+
+// ErrorPolicy is what a consumer does with a message which it fails to deserialize, as set by `--on-error`.
+// The zero value stops the consumer.
+type ErrorPolicy struct {
+	Action          string
+	RawEncoding     string
+	DeadLetterQueue deadLetterQueue
+}
+
+// deadLetterQueue stores the messages which a consumer fails to deserialize.
+type deadLetterQueue interface {
+	write(message *ckafka.Message, reason error) error
+	close()
+}
+
+// rawMessage is a message printed with `--on-error print-raw --output json`.
+type rawMessage struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+	Timestamp int64  `json:"timestamp"`
+	Error     string `json:"error"`
+	RawKey    string `json:"raw_key"`
+	RawValue  string `json:"raw_value"`
+}
+
+// deadLetterRecord is a line of a dead letter file. Keys, values, and header values are stored as raw bytes.
+type deadLetterRecord struct {
+	Topic     string           `json:"topic"`
+	Partition int32            `json:"partition"`
+	Offset    int64            `json:"offset"`
+	Timestamp int64            `json:"timestamp"`
+	Error     string           `json:"error"`
+	Key       []byte           `json:"key"`
+	Value     []byte           `json:"value"`
+	Headers   []archivedHeader `json:"headers,omitempty"`
+}
+
+func addOnErrorFlags(cmd *cobra.Command) {
+	cmd.Flags().String("on-error", onErrorFail, `Action to take on a message which cannot be deserialized: "skip" reports its partition, offset, and error and continues, "print-raw" also prints its raw key and value, "fail" stops the consumer, and "dlq=<file or topic>" also writes it to a dead letter file, if the value ends in ".json" or ".jsonl" or contains a path separator, or else produces it to a dead letter topic.`)
+	cmd.Flags().String("raw-encoding", "base64", fmt.Sprintf(`Encoding of the raw key and value printed with "--on-error print-raw", as %s.`, utils.ArrayToCommaDelimitedString(rawEncodings, "or")))
+	pcmd.RegisterFlagCompletionFunc(cmd, "on-error", func(_ *cobra.Command, _ []string) []string { return onErrorActions })
+	pcmd.RegisterFlagCompletionFunc(cmd, "raw-encoding", func(_ *cobra.Command, _ []string) []string { return rawEncodings })
+}
+
+// getErrorPolicy reads `--on-error` and `--raw-encoding`. The producer of a dead letter topic is only created if needed.
+func getErrorPolicy(cmd *cobra.Command, newDeadLetterProducer func() (*ckafka.Producer, error)) (ErrorPolicy, error) {
+	onError, err := cmd.Flags().GetString("on-error")
+	if err != nil {
+		return ErrorPolicy{}, err
+	}
+
+	rawEncoding, err := cmd.Flags().GetString("raw-encoding")
+	if err != nil {
+		return ErrorPolicy{}, err
+	}
+	if !slices.Contains(rawEncodings, rawEncoding) {
+		return ErrorPolicy{}, fmt.Errorf("invalid value for `--raw-encoding`: must be %s", utils.ArrayToCommaDelimitedString(rawEncodings, "or"))
+	}
+
+	policy := ErrorPolicy{RawEncoding: rawEncoding}
+
+	action, target, hasTarget := strings.Cut(onError, "=")
+	switch {
+	case action == onErrorDLQ && target != "":
+		policy.Action = onErrorDLQ
+		if isDeadLetterFile(target) {
+			file, err := os.OpenFile(target, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+			if err != nil {
+				return ErrorPolicy{}, err
+			}
+			policy.DeadLetterQueue = &deadLetterFile{file: file, encoder: json.NewEncoder(file)}
+		} else {
+			producer, err := newDeadLetterProducer()
+			if err != nil {
+				return ErrorPolicy{}, err
+			}
+			policy.DeadLetterQueue = &deadLetterTopic{producer: producer, topic: target}
+		}
+	case !hasTarget && slices.Contains([]string{onErrorSkip, onErrorPrintRaw, onErrorFail}, action):
+		policy.Action = action
+	default:
+		return ErrorPolicy{}, fmt.Errorf("invalid value for `--on-error`: must be \"skip\", \"print-raw\", \"fail\", or \"dlq=<file or topic>\"")
+	}
+
+	return policy, nil
+}
+
+func isDeadLetterFile(target string) bool {
+	return strings.ContainsAny(target, `/\`) || slices.Contains([]string{".json", ".jsonl"}, filepath.Ext(target))
+}
+
+func (p ErrorPolicy) stopsConsumer() bool {
+	return p.Action == "" || p.Action == onErrorFail
+}
+
+// handle reports a message which could not be deserialized, and then prints or stores it according to the policy.
+func (p ErrorPolicy) handle(out io.Writer, message *ckafka.Message, reason error, format output.Format) error {
+	output.ErrPrintf(false, "%% Failed to deserialize message at partition %d, offset %d: %v\n", message.TopicPartition.Partition, message.TopicPartition.Offset, reason)
+
+	switch p.Action {
+	case onErrorPrintRaw:
+		return p.printRaw(out, message, reason, format)
+	case onErrorDLQ:
+		if err := p.DeadLetterQueue.write(message, reason); err != nil {
+			return fmt.Errorf("failed to write message at partition %d, offset %d to the dead letter queue: %w", message.TopicPartition.Partition, message.TopicPartition.Offset, err)
+		}
+	}
+	return nil
+}
+
+func (p ErrorPolicy) printRaw(out io.Writer, message *ckafka.Message, reason error, format output.Format) error {
+	if format == output.JSON {
+		record := &rawMessage{
+			Partition: message.TopicPartition.Partition,
+			Offset:    int64(message.TopicPartition.Offset),
+			Timestamp: message.Timestamp.UnixMilli(),
+			Error:     reason.Error(),
+			RawKey:    p.encode(message.Key),
+			RawValue:  p.encode(message.Value),
+		}
+		if message.TopicPartition.Topic != nil {
+			record.Topic = *message.TopicPartition.Topic
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	}
+
+	_, err := fmt.Fprintf(out, "%% Raw key (%s): %s\n%% Raw value (%s): %s\n", p.RawEncoding, p.encode(message.Key), p.RawEncoding, p.encode(message.Value))
+	return err
+}
+
+func (p ErrorPolicy) encode(data []byte) string {
+	if p.RawEncoding == "hex" {
+		return hex.EncodeToString(data)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func (p ErrorPolicy) close() {
+	if p.DeadLetterQueue != nil {
+		p.DeadLetterQueue.close()
+	}
+}
+
+type deadLetterFile struct {
+	file    *os.File
+	encoder *json.Encoder
+}
+
+func (f *deadLetterFile) write(message *ckafka.Message, reason error) error {
+	record := &deadLetterRecord{
+		Partition: message.TopicPartition.Partition,
+		Offset:    int64(message.TopicPartition.Offset),
+		Timestamp: message.Timestamp.UnixMilli(),
+		Error:     reason.Error(),
+		Key:       message.Key,
+		Value:     message.Value,
+	}
+	if message.TopicPartition.Topic != nil {
+		record.Topic = *message.TopicPartition.Topic
+	}
+	for _, header := range message.Headers {
+		record.Headers = append(record.Headers, archivedHeader{Key: header.Key, Value: header.Value})
+	}
+
+	return f.encoder.Encode(record)
+}
+
+func (f *deadLetterFile) close() {
+	_ = f.file.Close()
+}
+
+// deadLetterTopic produces the messages to a topic, with their original key, value, and headers, along with headers
+// recording the error and where each message was consumed from.
+type deadLetterTopic struct {
+	producer *ckafka.Producer
+	topic    string
+}
+
+func (t *deadLetterTopic) write(message *ckafka.Message, reason error) error {
+	headers := slices.Clone(message.Headers)
+	headers = append(headers, ckafka.Header{Key: deadLetterErrorHeader, Value: []byte(reason.Error())})
+	if message.TopicPartition.Topic != nil {
+		headers = append(headers, ckafka.Header{Key: deadLetterTopicHeader, Value: []byte(*message.TopicPartition.Topic)})
+	}
+	headers = append(headers,
+		ckafka.Header{Key: deadLetterPartitionHeader, Value: []byte(strconv.Itoa(int(message.TopicPartition.Partition)))},
+		ckafka.Header{Key: deadLetterOffsetHeader, Value: []byte(strconv.FormatInt(int64(message.TopicPartition.Offset), 10))},
+	)
+
+	deadLetter := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &t.topic, Partition: ckafka.PartitionAny},
+		Key:            message.Key,
+		Value:          message.Value,
+		Headers:        headers,
+		Timestamp:      message.Timestamp,
+	}
+
+	deliveryChan := make(chan ckafka.Event, 1)
+	if err := t.producer.Produce(deadLetter, deliveryChan); err != nil {
+		return err
+	}
+	return (<-deliveryChan).(*ckafka.Message).TopicPartition.Error
+}
+
+func (t *deadLetterTopic) close() {
+	t.producer.Flush(5000)
+	t.producer.Close()
+}
@@ -0,0 +1,146 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func newErrorPolicyCommand(t *testing.T, args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	addOnErrorFlags(cmd)
+	require.NoError(t, cmd.ParseFlags(args))
+	return cmd
+}
+
+func noDeadLetterProducer() (*ckafka.Producer, error) {
+	panic("unexpected dead letter producer")
+}
+
+func TestGetErrorPolicy(t *testing.T) {
+	for _, action := range []string{onErrorSkip, onErrorPrintRaw, onErrorFail} {
+		policy, err := getErrorPolicy(newErrorPolicyCommand(t, "--on-error", action), noDeadLetterProducer)
+		require.NoError(t, err)
+		require.Equal(t, action, policy.Action)
+		require.Equal(t, "base64", policy.RawEncoding)
+	}
+
+	for _, onError := range []string{"ignore", "dlq", "dlq=", "skip=file.json"} {
+		_, err := getErrorPolicy(newErrorPolicyCommand(t, "--on-error", onError), noDeadLetterProducer)
+		require.Error(t, err)
+	}
+
+	_, err := getErrorPolicy(newErrorPolicyCommand(t, "--raw-encoding", "base32"), noDeadLetterProducer)
+	require.Error(t, err)
+}
+
+func TestIsDeadLetterFile(t *testing.T) {
+	require.True(t, isDeadLetterFile("dlq.json"))
+	require.True(t, isDeadLetterFile("dlq.jsonl"))
+	require.True(t, isDeadLetterFile("./dlq"))
+	require.False(t, isDeadLetterFile("my-topic-dlq"))
+	require.False(t, isDeadLetterFile("my.topic"))
+}
+
+func newMalformedMessage() *ckafka.Message {
+	topic := "topic"
+	return &ckafka.Message{
+		Key:            []byte("key"),
+		Value:          []byte{0xde, 0xad},
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 1, Offset: 2},
+		Timestamp:      time.UnixMilli(1000),
+		Headers:        []ckafka.Header{{Key: "a", Value: []byte("1")}},
+	}
+}
+
+func TestHandleMessage_Fail(t *testing.T) {
+	buf := new(bytes.Buffer)
+	h := &GroupHandler{KeyFormat: "string", ValueFormat: "avro", Out: buf}
+	require.Error(t, h.handleMessage(newMalformedMessage()))
+	require.Empty(t, buf.String())
+}
+
+func TestHandleMessage_Skip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat:   "string",
+		ValueFormat: "avro",
+		Out:         buf,
+		Properties:  ConsumerProperties{PrintKey: true, Delimiter: "\t"},
+		ErrorPolicy: ErrorPolicy{Action: onErrorSkip},
+	}
+	require.NoError(t, h.handleMessage(newMalformedMessage()))
+	require.Empty(t, buf.String())
+}
+
+func TestHandleMessage_PrintRaw(t *testing.T) {
+	buf := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat:   "string",
+		ValueFormat: "avro",
+		Out:         buf,
+		ErrorPolicy: ErrorPolicy{Action: onErrorPrintRaw, RawEncoding: "hex"},
+	}
+	require.NoError(t, h.handleMessage(newMalformedMessage()))
+	require.Equal(t, "% Raw key (hex): 6b6579\n% Raw value (hex): dead\n", buf.String())
+}
+
+func TestHandleMessage_PrintRawAsJson(t *testing.T) {
+	buf := new(bytes.Buffer)
+	h := &GroupHandler{
+		KeyFormat:   "string",
+		ValueFormat: "avro",
+		Out:         buf,
+		Properties:  ConsumerProperties{Output: output.JSON},
+		ErrorPolicy: ErrorPolicy{Action: onErrorPrintRaw, RawEncoding: "base64"},
+	}
+	require.NoError(t, h.handleMessage(newMalformedMessage()))
+
+	record := new(rawMessage)
+	require.NoError(t, json.Unmarshal(buf.Bytes(), record))
+	require.Equal(t, "topic", record.Topic)
+	require.Equal(t, int32(1), record.Partition)
+	require.Equal(t, int64(2), record.Offset)
+	require.Equal(t, "a2V5", record.RawKey)
+	require.Equal(t, "3q0=", record.RawValue)
+	require.NotEmpty(t, record.Error)
+}
+
+func TestHandleMessage_DeadLetterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dlq.jsonl")
+	policy, err := getErrorPolicy(newErrorPolicyCommand(t, "--on-error", "dlq="+path), noDeadLetterProducer)
+	require.NoError(t, err)
+
+	h := &GroupHandler{
+		KeyFormat:   "string",
+		ValueFormat: "avro",
+		Out:         new(bytes.Buffer),
+		ErrorPolicy: policy,
+	}
+	require.NoError(t, h.handleMessage(newMalformedMessage()))
+	require.NoError(t, h.handleMessage(newMalformedMessage()))
+	policy.close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	record := new(deadLetterRecord)
+	require.NoError(t, json.Unmarshal(lines[0], record))
+	require.Equal(t, "topic", record.Topic)
+	require.Equal(t, int64(2), record.Offset)
+	require.Equal(t, []byte("key"), record.Key)
+	require.Equal(t, []byte{0xde, 0xad}, record.Value)
+	require.Equal(t, []archivedHeader{{Key: "a", Value: []byte("1")}}, record.Headers)
+}
@@ -0,0 +1,200 @@
+package kafka
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+const (
+	groupOffsetArchiveVersion = 1
+
+	offsetTimestampsTimeout = 30 * time.Second
+)
+
+// GroupOffsetArchive is the file written by `kafka consumer group offset export` and read by `kafka consumer group offset import`.
+type GroupOffsetArchive struct {
+	Version         int              `json:"version"`
+	ConsumerGroupId string           `json:"consumer_group_id"`
+	Offsets         []archivedOffset `json:"offsets"`
+}
+
+// archivedOffset is the committed offset of a partition. The timestamp, in milliseconds, is that of the next message
+// the group would have consumed, and is not set if the group had consumed every message of the partition.
+type archivedOffset struct {
+	Topic        string `json:"topic"`
+	Partition    int32  `json:"partition"`
+	Offset       int64  `json:"offset"`
+	LogEndOffset int64  `json:"log_end_offset"`
+	Timestamp    int64  `json:"timestamp,omitempty"`
+}
+
+func NewGroupOffsetArchive(group string) *GroupOffsetArchive {
+	return &GroupOffsetArchive{
+		Version:         groupOffsetArchiveVersion,
+		ConsumerGroupId: group,
+		Offsets:         []archivedOffset{},
+	}
+}
+
+func ReadGroupOffsetArchive(path string) (*GroupOffsetArchive, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := new(GroupOffsetArchive)
+	if err := json.Unmarshal(data, archive); err != nil {
+		return nil, fmt.Errorf(`failed to parse consumer group offset archive "%s": %w`, path, err)
+	}
+	if archive.Version != groupOffsetArchiveVersion {
+		return nil, fmt.Errorf(`unsupported consumer group offset archive version %d in "%s"`, archive.Version, path)
+	}
+
+	return archive, nil
+}
+
+func (a *GroupOffsetArchive) Write(path string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// addLag adds the committed offset of a partition, as reported by the consumer lag of the group. Partitions without a
+// committed offset are skipped.
+func (a *GroupOffsetArchive) addLag(topic string, partition int32, currentOffset, logEndOffset int64) {
+	if currentOffset < 0 {
+		return
+	}
+
+	a.Offsets = append(a.Offsets, archivedOffset{
+		Topic:        topic,
+		Partition:    partition,
+		Offset:       currentOffset,
+		LogEndOffset: logEndOffset,
+	})
+}
+
+func (a *GroupOffsetArchive) sort() {
+	sort.Slice(a.Offsets, func(i, j int) bool {
+		if a.Offsets[i].Topic != a.Offsets[j].Topic {
+			return a.Offsets[i].Topic < a.Offsets[j].Topic
+		}
+		return a.Offsets[i].Partition < a.Offsets[j].Partition
+	})
+}
+
+// ReadTimestamps sets the timestamp of each archived offset to that of the first message at or after the offset, so
+// that the offsets can be translated by timestamp on another cluster.
+func (a *GroupOffsetArchive) ReadTimestamps(consumer *ckafka.Consumer, timeout time.Duration) error {
+	pending := make(map[string]*archivedOffset)
+	var partitions []ckafka.TopicPartition
+	for i := range a.Offsets {
+		offset := &a.Offsets[i]
+		if offset.Offset >= offset.LogEndOffset {
+			continue
+		}
+
+		partition := ckafka.TopicPartition{Topic: &offset.Topic, Partition: offset.Partition, Offset: ckafka.Offset(offset.Offset)}
+		pending[topicPartitionKey(partition)] = offset
+		partitions = append(partitions, partition)
+	}
+
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	if err := consumer.Assign(partitions); err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for len(pending) > 0 && time.Now().Before(deadline) {
+		switch e := consumer.Poll(100).(type) {
+		case *ckafka.Message:
+			key := topicPartitionKey(e.TopicPartition)
+			if offset, ok := pending[key]; ok {
+				offset.Timestamp = e.Timestamp.UnixMilli()
+				delete(pending, key)
+			}
+		case ckafka.Error:
+			if e.IsFatal() {
+				return e
+			}
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("timed out after %s reading the timestamps of %d partition(s)", timeout, len(pending))
+	}
+	return nil
+}
+
+// planOffsetImport returns the partitions of the archive, with their committed offsets, and their new offsets. If
+// translate is set, the new offset of each partition is the first offset whose timestamp is at or after the archived
+// timestamp, rather than the archived offset.
+func planOffsetImport(client offsetResetClient, archive *GroupOffsetArchive, translate bool) ([]ckafka.TopicPartition, []ckafka.TopicPartition, error) {
+	topics := make(map[string][]int32)
+	archived := make(map[string]archivedOffset)
+	for _, offset := range archive.Offsets {
+		topics[offset.Topic] = append(topics[offset.Topic], offset.Partition)
+		archived[topicPartitionKey(ckafka.TopicPartition{Topic: &offset.Topic, Partition: offset.Partition})] = offset
+	}
+
+	if len(topics) == 0 {
+		return nil, nil, nil
+	}
+
+	partitions, err := getOffsetResetPartitions(client, topics)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timestampOffsets := make(map[string]int64)
+	if translate {
+		var times []ckafka.TopicPartition
+		for _, partition := range partitions {
+			if timestamp := archived[topicPartitionKey(partition)].Timestamp; timestamp > 0 {
+				times = append(times, ckafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(timestamp)})
+			}
+		}
+
+		if len(times) > 0 {
+			offsets, err := client.OffsetsForTimes(times, offsetResetTimeoutMs)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, offset := range offsets {
+				timestampOffsets[topicPartitionKey(offset)] = int64(offset.Offset)
+			}
+		}
+	}
+
+	newOffsets := make([]ckafka.TopicPartition, len(partitions))
+	for i, partition := range partitions {
+		low, high, err := client.QueryWatermarkOffsets(*partition.Topic, partition.Partition, offsetResetTimeoutMs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		key := topicPartitionKey(partition)
+		offset := archived[key].Offset
+		if translate {
+			// Without a timestamp, the group had consumed every message of the partition.
+			offset = high
+			if timestampOffset, ok := timestampOffsets[key]; ok && timestampOffset >= 0 {
+				offset = timestampOffset
+			}
+		}
+		newOffsets[i] = ckafka.TopicPartition{Topic: partition.Topic, Partition: partition.Partition, Offset: ckafka.Offset(clampOffset(offset, low, high))}
+	}
+
+	return partitions, newOffsets, nil
+}
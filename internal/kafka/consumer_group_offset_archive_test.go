@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+func TestGroupOffsetArchive_RoundTrip(t *testing.T) {
+	archive := NewGroupOffsetArchive("my-group")
+	archive.addLag("orders", 1, 40, 50)
+	archive.addLag("orders", 0, 10, 10)
+	archive.addLag("payments", 0, -1, 20)
+	archive.Offsets[0].Timestamp = 1700000000000
+	archive.sort()
+
+	path := filepath.Join(t.TempDir(), "offsets.json")
+	require.NoError(t, archive.Write(path))
+
+	read, err := ReadGroupOffsetArchive(path)
+	require.NoError(t, err)
+	require.Equal(t, archive, read)
+	require.Equal(t, []archivedOffset{
+		{Topic: "orders", Partition: 0, Offset: 10, LogEndOffset: 10},
+		{Topic: "orders", Partition: 1, Offset: 40, LogEndOffset: 50, Timestamp: 1700000000000},
+	}, read.Offsets)
+}
+
+func TestPlanOffsetImport(t *testing.T) {
+	client := &fakeOffsetResetClient{
+		partitions: map[string]int32{"orders": 2},
+		committed:  map[string]int64{"orders:0": 3},
+		watermarks: [2]int64{5, 100},
+		timestamps: map[string]int64{"orders:1": 60},
+	}
+
+	archive := NewGroupOffsetArchive("my-group")
+	archive.Offsets = []archivedOffset{
+		{Topic: "orders", Partition: 0, Offset: 2, LogEndOffset: 10},
+		{Topic: "orders", Partition: 1, Offset: 40, LogEndOffset: 50, Timestamp: 1700000000000},
+	}
+
+	partitions, newOffsets, err := planOffsetImport(client, archive, false)
+	require.NoError(t, err)
+	require.Equal(t, ckafka.Offset(3), partitions[0].Offset)
+	require.Equal(t, ckafka.OffsetInvalid, partitions[1].Offset)
+	require.Equal(t, ckafka.Offset(5), newOffsets[0].Offset)
+	require.Equal(t, ckafka.Offset(40), newOffsets[1].Offset)
+
+	_, newOffsets, err = planOffsetImport(client, archive, true)
+	require.NoError(t, err)
+	require.Equal(t, ckafka.Offset(100), newOffsets[0].Offset)
+	require.Equal(t, ckafka.Offset(60), newOffsets[1].Offset)
+
+	archive.Offsets = append(archive.Offsets, archivedOffset{Topic: "missing", Partition: 0})
+	_, _, err = planOffsetImport(client, archive, false)
+	require.Error(t, err)
+}
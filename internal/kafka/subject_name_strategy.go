@@ -0,0 +1,208 @@
+package kafka
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	sr "github.com/confluentinc/cli/v3/internal/schema-registry"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const (
+	topicNameStrategyName       = "TopicName"
+	recordNameStrategyName      = "RecordName"
+	topicRecordNameStrategyName = "TopicRecordName"
+)
+
+var subjectNameStrategies = []string{topicNameStrategyName, recordNameStrategyName, topicRecordNameStrategyName}
+
+// producerSchema is the schema of a message key or value to be produced. If set, loadSchemaSuggestions is suggested
+// when the schema cannot be loaded.
+type producerSchema struct {
+	topic                 string
+	mode                  string
+	format                string
+	schemaPath            string
+	schemaDir             string
+	refs                  []srsdk.SchemaReference
+	loadSchemaSuggestions string
+}
+
+func addSubjectNameStrategyFlags(cmd *cobra.Command) {
+	strategies := utils.ArrayToCommaDelimitedString(subjectNameStrategies, "or")
+	cmd.Flags().String("key-subject-name-strategy", topicNameStrategyName, fmt.Sprintf("The subject name strategy of the message key schema, as %s.", strategies))
+	cmd.Flags().String("value-subject-name-strategy", topicNameStrategyName, fmt.Sprintf("The subject name strategy of the message value schema, as %s.", strategies))
+	cmd.Flags().String("key-subject", "", "The subject of the message key schema, instead of the subject chosen by the subject name strategy.")
+	cmd.Flags().String("subject", "", "The subject of the message value schema, instead of the subject chosen by the subject name strategy.")
+	cmd.Flags().Bool("auto-register", true, `Register the message key and value schemas under their subjects. If "false", each schema must already be registered under its subject.`)
+	cmd.Flags().Bool("use-latest-version", false, `Serialize with the latest schema version of each subject rather than with a schema file. Requires "--auto-register=false".`)
+
+	pcmd.RegisterFlagCompletionFunc(cmd, "key-subject-name-strategy", func(_ *cobra.Command, _ []string) []string { return subjectNameStrategies })
+	pcmd.RegisterFlagCompletionFunc(cmd, "value-subject-name-strategy", func(_ *cobra.Command, _ []string) []string { return subjectNameStrategies })
+
+	cmd.MarkFlagsMutuallyExclusive("key-subject", "key-subject-name-strategy")
+	cmd.MarkFlagsMutuallyExclusive("subject", "value-subject-name-strategy")
+}
+
+// getSubject returns the subject of a message key or value schema, either as set by "--key-subject" or "--subject", or
+// as chosen by the subject name strategy. The RecordName strategies require the schema to be loaded by the serializer.
+func getSubject(cmd *cobra.Command, topic, mode string, serializer serdes.SerializationProvider, hasSchema bool) (string, error) {
+	subject, err := getSubjectFlag(cmd, mode)
+	if err != nil {
+		return "", err
+	}
+	if subject != "" {
+		return subject, nil
+	}
+
+	strategyFlagName := fmt.Sprintf("%s-subject-name-strategy", mode)
+	strategy, err := cmd.Flags().GetString(strategyFlagName)
+	if err != nil {
+		return "", err
+	}
+
+	switch strategy {
+	case topicNameStrategyName:
+		return topicNameStrategy(topic, mode), nil
+	case recordNameStrategyName, topicRecordNameStrategyName:
+		recordNameProvider, ok := serializer.(serdes.RecordNameProvider)
+		if !ok || !hasSchema {
+			return "", fmt.Errorf("the %s subject name strategy requires a schema file for the message %s", strategy, mode)
+		}
+		recordName, err := recordNameProvider.GetRecordName()
+		if err != nil {
+			return "", err
+		}
+		if strategy == recordNameStrategyName {
+			return recordName, nil
+		}
+		return fmt.Sprintf("%s-%s", topic, recordName), nil
+	default:
+		return "", fmt.Errorf("invalid value for `--%s`: must be %s", strategyFlagName, utils.ArrayToCommaDelimitedString(subjectNameStrategies, "or"))
+	}
+}
+
+func getSubjectFlag(cmd *cobra.Command, mode string) (string, error) {
+	if mode == "key" {
+		return cmd.Flags().GetString("key-subject")
+	}
+	return cmd.Flags().GetString("subject")
+}
+
+// loadProducerSchema creates the serializer of a message key or value and returns it along with the meta info which
+// prefixes each serialized message. A schema file is registered under its subject, or only looked up with
+// "--auto-register=false". With "--use-latest-version", the latest schema version of the subject is used instead.
+// The Schema Registry client is only created if a schema is needed.
+func loadProducerSchema(cmd *cobra.Command, schema *producerSchema, getSrClient func() (*schemaregistry.Client, error)) (serdes.SerializationProvider, []byte, error) {
+	autoRegister, err := cmd.Flags().GetBool("auto-register")
+	if err != nil {
+		return nil, nil, err
+	}
+	useLatestVersion, err := cmd.Flags().GetBool("use-latest-version")
+	if err != nil {
+		return nil, nil, err
+	}
+	if useLatestVersion && autoRegister {
+		return nil, nil, fmt.Errorf("`--use-latest-version` requires `--auto-register=false`")
+	}
+
+	serializer, err := serdes.GetSerializationProvider(schema.format)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := setMessageType(cmd, schema.mode, serializer); err != nil {
+		return nil, nil, err
+	}
+
+	schemaBased := slices.Contains(serdes.SchemaBasedFormats, schema.format)
+	hasSchema := schemaBased && schema.schemaPath != ""
+	useLatestVersion = useLatestVersion && schemaBased
+	if !hasSchema && !useLatestVersion {
+		if err := serializer.LoadSchema(schema.schemaPath, map[string]string{}); err != nil {
+			return nil, nil, schema.wrapLoadSchemaError(err)
+		}
+		return serializer, []byte{}, nil
+	}
+
+	srClient, err := getSrClient()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	referencePathMap := map[string]string{}
+	if hasSchema {
+		referencePathMap, err = sr.StoreSchemaReferences(schema.schemaDir, schema.refs, srClient)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := serializer.LoadSchema(schema.schemaPath, referencePathMap); err != nil {
+			return nil, nil, schema.wrapLoadSchemaError(err)
+		}
+	}
+
+	subject, err := getSubject(cmd, schema.topic, schema.mode, serializer, hasSchema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if useLatestVersion {
+		latest, err := srClient.GetSchemaByVersion(subject, "latest", nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		format, err := serdes.FormatTranslation(latest.SchemaType)
+		if err != nil {
+			return nil, nil, err
+		}
+		if format != schema.format {
+			return nil, nil, fmt.Errorf(`the latest schema of subject "%s" is in the %s format, not the %s format`, subject, format, schema.format)
+		}
+
+		schemaString := srsdk.SchemaString{SchemaType: latest.SchemaType, Schema: latest.Schema, References: latest.References}
+		schemaPath, referencePathMap, err := sr.SetSchemaPathRef(schemaString, schema.schemaDir, subject, latest.Id, srClient)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := serializer.LoadSchema(schemaPath, referencePathMap); err != nil {
+			return nil, nil, schema.wrapLoadSchemaError(err)
+		}
+		return serializer, sr.GetMetaInfoFromSchemaId(latest.Id), nil
+	}
+
+	schemaCfg := &sr.RegisterSchemaConfigs{
+		Subject:    subject,
+		SchemaDir:  schema.schemaDir,
+		SchemaType: serializer.GetSchemaName(),
+		Format:     schema.format,
+		SchemaPath: schema.schemaPath,
+		Refs:       schema.refs,
+	}
+
+	var id int32
+	if autoRegister {
+		id, err = sr.RegisterSchemaWithAuth(cmd, schemaCfg, srClient)
+	} else {
+		id, err = sr.LookUpSchemaWithAuth(schemaCfg, srClient)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return serializer, sr.GetMetaInfoFromSchemaId(id), nil
+}
+
+func (s *producerSchema) wrapLoadSchemaError(err error) error {
+	if s.loadSchemaSuggestions == "" {
+		return err
+	}
+	return errors.NewWrapErrorWithSuggestions(err, "failed to load schema", s.loadSchemaSuggestions)
+}
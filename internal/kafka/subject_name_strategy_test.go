@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+)
+
+func newSubjectNameStrategyCommand(t *testing.T, args ...string) *cobra.Command {
+	cmd := &cobra.Command{}
+	addSubjectNameStrategyFlags(cmd)
+	require.NoError(t, cmd.ParseFlags(args))
+	return cmd
+}
+
+func TestGetSubject(t *testing.T) {
+	schemaPath := filepath.Join(t.TempDir(), "order.avsc")
+	require.NoError(t, os.WriteFile(schemaPath, []byte(`{"type":"record","name":"Order","namespace":"io.confluent","fields":[{"name":"id","type":"int"}]}`), 0644))
+	serializer, err := serdes.GetSerializationProvider("avro")
+	require.NoError(t, err)
+	require.NoError(t, serializer.LoadSchema(schemaPath, map[string]string{}))
+
+	tests := []struct {
+		args     []string
+		mode     string
+		expected string
+	}{
+		{nil, "value", "orders-value"},
+		{nil, "key", "orders-key"},
+		{[]string{"--value-subject-name-strategy", "RecordName"}, "value", "io.confluent.Order"},
+		{[]string{"--value-subject-name-strategy", "TopicRecordName"}, "value", "orders-io.confluent.Order"},
+		{[]string{"--value-subject-name-strategy", "RecordName"}, "key", "orders-key"},
+		{[]string{"--key-subject-name-strategy", "TopicRecordName"}, "key", "orders-io.confluent.Order"},
+		{[]string{"--subject", "my-subject"}, "value", "my-subject"},
+		{[]string{"--key-subject", "my-key-subject"}, "key", "my-key-subject"},
+	}
+
+	for _, test := range tests {
+		subject, err := getSubject(newSubjectNameStrategyCommand(t, test.args...), "orders", test.mode, serializer, true)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, subject)
+	}
+}
+
+func TestGetSubject_Errors(t *testing.T) {
+	cmd := newSubjectNameStrategyCommand(t, "--value-subject-name-strategy", "RecordName")
+	_, err := getSubject(cmd, "orders", "value", new(serdes.AvroSerializationProvider), false)
+	require.EqualError(t, err, "the RecordName subject name strategy requires a schema file for the message value")
+
+	_, err = getSubject(cmd, "orders", "value", new(serdes.StringSerializationProvider), true)
+	require.Error(t, err)
+
+	cmd = newSubjectNameStrategyCommand(t, "--value-subject-name-strategy", "io.confluent.kafka.serializers.subject.RecordNameStrategy")
+	_, err = getSubject(cmd, "orders", "value", nil, false)
+	require.EqualError(t, err, "invalid value for `--value-subject-name-strategy`: must be \"TopicName\", \"RecordName\", or \"TopicRecordName\"")
+}
@@ -0,0 +1,395 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+)
+
+const (
+	topicArchiveVersion = 1
+
+	failedToImportRecordErrorMsg = "failed to produce record from offset %d of partition %d: %w"
+)
+
+// TopicArchive is the file written by `kafka topic export` and read by `kafka topic import`.
+// Keys, values, and header values are stored as raw bytes, so records are restored exactly as they were consumed.
+type TopicArchive struct {
+	Version     int              `json:"version"`
+	Topic       string           `json:"topic"`
+	KeyFormat   string           `json:"key_format"`
+	ValueFormat string           `json:"value_format"`
+	Schemas     []archivedSchema `json:"schemas,omitempty"`
+	Records     []archivedRecord `json:"records"`
+}
+
+// archivedSchema is a schema used by the archived records, or referenced by one of those schemas.
+// Subject and version are only set for referenced schemas, since references are resolved by subject and version.
+type archivedSchema struct {
+	Id         int32                   `json:"id"`
+	Subject    string                  `json:"subject,omitempty"`
+	Version    int32                   `json:"version,omitempty"`
+	SchemaType string                  `json:"schema_type,omitempty"`
+	Schema     string                  `json:"schema"`
+	References []srsdk.SchemaReference `json:"references,omitempty"`
+}
+
+type archivedRecord struct {
+	Partition     int32            `json:"partition"`
+	Offset        int64            `json:"offset"`
+	Timestamp     int64            `json:"timestamp"`
+	Key           []byte           `json:"key"`
+	Value         []byte           `json:"value"`
+	Headers       []archivedHeader `json:"headers,omitempty"`
+	KeySchemaId   int32            `json:"key_schema_id,omitempty"`
+	ValueSchemaId int32            `json:"value_schema_id,omitempty"`
+}
+
+type archivedHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+func NewTopicArchive(topic, keyFormat, valueFormat string) *TopicArchive {
+	return &TopicArchive{
+		Version:     topicArchiveVersion,
+		Topic:       topic,
+		KeyFormat:   keyFormat,
+		ValueFormat: valueFormat,
+		Records:     []archivedRecord{},
+	}
+}
+
+func ReadTopicArchive(path string) (*TopicArchive, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := new(TopicArchive)
+	if err := json.Unmarshal(data, archive); err != nil {
+		return nil, fmt.Errorf(`failed to parse topic archive "%s": %w`, path, err)
+	}
+	if archive.Version != topicArchiveVersion {
+		return nil, fmt.Errorf(`unsupported topic archive version %d in "%s"`, archive.Version, path)
+	}
+
+	return archive, nil
+}
+
+func (a *TopicArchive) Write(path string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func (a *TopicArchive) addMessage(message *ckafka.Message) {
+	record := archivedRecord{
+		Partition: message.TopicPartition.Partition,
+		Offset:    int64(message.TopicPartition.Offset),
+		Timestamp: message.Timestamp.UnixMilli(),
+		Key:       message.Key,
+		Value:     message.Value,
+	}
+
+	// Schema IDs are detected from the data rather than the formats, since a topic with schema-encoded data can be
+	// exported with the default formats. FetchSchemas discards IDs which turn out not to be in Schema Registry.
+	record.KeySchemaId = getSchemaId(message.Key)
+	record.ValueSchemaId = getSchemaId(message.Value)
+
+	for _, header := range message.Headers {
+		record.Headers = append(record.Headers, archivedHeader{Key: header.Key, Value: header.Value})
+	}
+
+	a.Records = append(a.Records, record)
+}
+
+// FetchSchemas adds the schemas used by the archived records, and the schemas they reference, to the archive.
+// Records which only appear to be encoded with a schema, because their data starts with a zero byte, are archived
+// without a schema ID if Schema Registry has no schema with that ID and their format is not schema-based.
+func (a *TopicArchive) FetchSchemas(client *schemaregistry.Client) error {
+	missing := make(map[int32]bool)
+	for i := range a.Records {
+		record := &a.Records[i]
+
+		keyId, err := a.fetchSchema(client, record.KeySchemaId, a.KeyFormat, missing)
+		if err != nil {
+			return err
+		}
+		record.KeySchemaId = keyId
+
+		valueId, err := a.fetchSchema(client, record.ValueSchemaId, a.ValueFormat, missing)
+		if err != nil {
+			return err
+		}
+		record.ValueSchemaId = valueId
+	}
+
+	return nil
+}
+
+// fetchSchema adds a schema and the schemas it references to the archive, and returns the schema ID to archive
+// with the record, which is 0 if the record is not encoded with a schema from Schema Registry.
+func (a *TopicArchive) fetchSchema(client *schemaregistry.Client, id int32, format string, missing map[int32]bool) (int32, error) {
+	isSchemaBased := slices.Contains(serdes.SchemaBasedFormats, format)
+	if id == 0 || a.getSchema(id) != nil {
+		return id, nil
+	}
+	if missing[id] && !isSchemaBased {
+		return 0, nil
+	}
+
+	schema, err := client.GetSchema(id, nil)
+	if err != nil {
+		if !isSchemaBased && schemaregistry.IsNotFoundError(err) {
+			missing[id] = true
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get schema %d: %w", id, err)
+	}
+	a.Schemas = append(a.Schemas, archivedSchema{
+		Id:         id,
+		SchemaType: schema.SchemaType,
+		Schema:     schema.Schema,
+		References: schema.References,
+	})
+
+	if err := a.fetchReferences(client, schema.References); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (a *TopicArchive) fetchReferences(client *schemaregistry.Client, references []srsdk.SchemaReference) error {
+	for _, reference := range references {
+		if a.getReference(reference) != nil {
+			continue
+		}
+
+		schema, err := client.GetSchemaByVersion(reference.Subject, strconv.Itoa(int(reference.Version)), nil)
+		if err != nil {
+			return fmt.Errorf(`failed to get version %d of subject "%s": %w`, reference.Version, reference.Subject, err)
+		}
+		a.Schemas = append(a.Schemas, archivedSchema{
+			Id:         schema.Id,
+			Subject:    schema.Subject,
+			Version:    schema.Version,
+			SchemaType: schema.SchemaType,
+			Schema:     schema.Schema,
+			References: schema.References,
+		})
+
+		if err := a.fetchReferences(client, schema.References); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterSchemas registers the archived schemas for the given topic and rewrites the schema IDs of the archived
+// records to match. Schemas are registered under the topic name strategy, and referenced schemas under their
+// original subjects.
+func (a *TopicArchive) RegisterSchemas(client *schemaregistry.Client, topic string) error {
+	registered := make(map[string]map[int32]int32)
+	for i := range a.Records {
+		record := &a.Records[i]
+
+		if record.KeySchemaId != 0 {
+			id, err := a.registerRecordSchema(client, topicNameStrategy(topic, "key"), record.KeySchemaId, registered)
+			if err != nil {
+				return err
+			}
+			record.KeySchemaId = id
+			setSchemaId(record.Key, id)
+		}
+
+		if record.ValueSchemaId != 0 {
+			id, err := a.registerRecordSchema(client, topicNameStrategy(topic, "value"), record.ValueSchemaId, registered)
+			if err != nil {
+				return err
+			}
+			record.ValueSchemaId = id
+			setSchemaId(record.Value, id)
+		}
+	}
+
+	return nil
+}
+
+func (a *TopicArchive) registerRecordSchema(client *schemaregistry.Client, subject string, id int32, registered map[string]map[int32]int32) (int32, error) {
+	schema := a.getSchema(id)
+	if schema == nil {
+		return 0, fmt.Errorf("schema %d is missing from the topic archive", id)
+	}
+	return a.registerSchema(client, subject, schema, registered)
+}
+
+func (a *TopicArchive) registerSchema(client *schemaregistry.Client, subject string, schema *archivedSchema, registered map[string]map[int32]int32) (int32, error) {
+	if id, ok := registered[subject][schema.Id]; ok {
+		return id, nil
+	}
+
+	references := make([]srsdk.SchemaReference, len(schema.References))
+	for i, reference := range schema.References {
+		referencedSchema := a.getReference(reference)
+		if referencedSchema == nil {
+			return 0, fmt.Errorf(`version %d of subject "%s" is missing from the topic archive`, reference.Version, reference.Subject)
+		}
+		id, err := a.registerSchema(client, reference.Subject, referencedSchema, registered)
+		if err != nil {
+			return 0, err
+		}
+		version, err := getSchemaVersion(client, reference.Subject, id)
+		if err != nil {
+			return 0, err
+		}
+		references[i] = srsdk.SchemaReference{Name: reference.Name, Subject: reference.Subject, Version: version}
+	}
+
+	req := srsdk.RegisterSchemaRequest{
+		SchemaType: schema.SchemaType,
+		Schema:     schema.Schema,
+		References: references,
+	}
+	res, err := client.Register(subject, req, nil)
+	if err != nil {
+		return 0, fmt.Errorf(`failed to register schema %d under subject "%s": %w`, schema.Id, subject, err)
+	}
+
+	if registered[subject] == nil {
+		registered[subject] = make(map[int32]int32)
+	}
+	registered[subject][schema.Id] = res.Id
+
+	return res.Id, nil
+}
+
+func (a *TopicArchive) getSchema(id int32) *archivedSchema {
+	for i := range a.Schemas {
+		if a.Schemas[i].Id == id {
+			return &a.Schemas[i]
+		}
+	}
+	return nil
+}
+
+func (a *TopicArchive) getReference(reference srsdk.SchemaReference) *archivedSchema {
+	for i := range a.Schemas {
+		if a.Schemas[i].Subject == reference.Subject && a.Schemas[i].Version == reference.Version {
+			return &a.Schemas[i]
+		}
+	}
+	return nil
+}
+
+// hasSchemas returns true if any archived record is encoded with a schema from Schema Registry.
+func (a *TopicArchive) hasSchemas() bool {
+	return slices.ContainsFunc(a.Records, func(record archivedRecord) bool {
+		return record.KeySchemaId != 0 || record.ValueSchemaId != 0
+	})
+}
+
+func (r archivedRecord) toMessage(topic string, keepPartition bool) *ckafka.Message {
+	message := &ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: ckafka.PartitionAny},
+		Key:            r.Key,
+		Value:          r.Value,
+		Timestamp:      time.UnixMilli(r.Timestamp),
+	}
+	if keepPartition {
+		message.TopicPartition.Partition = r.Partition
+	}
+
+	for _, header := range r.Headers {
+		message.Headers = append(message.Headers, ckafka.Header{Key: header.Key, Value: header.Value})
+	}
+
+	return message
+}
+
+// ExportTopic reads every partition of the archive's topic from the beginning, until the end of each partition.
+func ExportTopic(consumer *ckafka.Consumer, archive *TopicArchive, out io.Writer, timeout time.Duration) error {
+	if err := consumer.Subscribe(archive.Topic, GetRebalanceCallback(ckafka.OffsetBeginning, PartitionFilter{})); err != nil {
+		return err
+	}
+
+	groupHandler := &GroupHandler{
+		Out:     out,
+		Archive: archive,
+		Bounds: ConsumerBounds{
+			ExitOnEOF: true,
+			Timeout:   timeout,
+		},
+	}
+	return RunConsumer(consumer, groupHandler)
+}
+
+// ImportTopic produces the archived records to a topic, waiting for each record to be delivered before producing the next.
+func ImportTopic(producer *ckafka.Producer, archive *TopicArchive, topic string, keepPartitions bool) (int, error) {
+	deliveryChan := make(chan ckafka.Event)
+	for i, record := range archive.Records {
+		if err := producer.Produce(record.toMessage(topic, keepPartitions), deliveryChan); err != nil {
+			if isProduceToCompactedTopicError, err := errors.CatchProduceToCompactedTopicError(err, topic); isProduceToCompactedTopicError {
+				return i, err
+			}
+			return i, fmt.Errorf(failedToImportRecordErrorMsg, record.Offset, record.Partition, err)
+		}
+
+		m := (<-deliveryChan).(*ckafka.Message)
+		if m.TopicPartition.Error != nil {
+			return i, fmt.Errorf(failedToImportRecordErrorMsg, record.Offset, record.Partition, m.TopicPartition.Error)
+		}
+	}
+
+	return len(archive.Records), nil
+}
+
+func getSchemaVersion(client *schemaregistry.Client, subject string, id int32) (int32, error) {
+	versions, err := client.ListVersions(subject, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := len(versions) - 1; i >= 0; i-- {
+		schema, err := client.GetSchemaByVersion(subject, strconv.Itoa(int(versions[i])), nil)
+		if err != nil {
+			return 0, err
+		}
+		if schema.Id == id {
+			return schema.Version, nil
+		}
+	}
+
+	return 0, fmt.Errorf(`schema %d is not registered under subject "%s"`, id, subject)
+}
+
+// getSchemaId returns the schema ID stored in the meta info of a message encoded with a schema, or 0 if there is none.
+func getSchemaId(data []byte) int32 {
+	if len(data) < messageOffset || data[0] != 0x0 {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(data[1:messageOffset]))
+}
+
+func setSchemaId(data []byte, id int32) {
+	if len(data) >= messageOffset {
+		binary.BigEndian.PutUint32(data[1:messageOffset], uint32(id))
+	}
+}
@@ -0,0 +1,177 @@
+package kafka
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+)
+
+func TestTopicArchive_RoundTrip(t *testing.T) {
+	topic := "topic"
+	archive := NewTopicArchive(topic, "string", "avro")
+	archive.addMessage(&ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 2, Offset: 7},
+		Key:            []byte("key"),
+		Value:          []byte{0, 0, 0, 0, 100, 1, 2},
+		Headers:        []ckafka.Header{{Key: "a", Value: []byte("1")}, {Key: "a"}},
+		Timestamp:      time.UnixMilli(1700000000000),
+	})
+	archive.addMessage(&ckafka.Message{
+		TopicPartition: ckafka.TopicPartition{Topic: &topic, Partition: 0, Offset: 0},
+		Timestamp:      time.UnixMilli(1700000000001),
+	})
+
+	path := filepath.Join(t.TempDir(), "archive.json")
+	require.NoError(t, archive.Write(path))
+
+	read, err := ReadTopicArchive(path)
+	require.NoError(t, err)
+	require.Equal(t, archive, read)
+	require.True(t, read.hasSchemas())
+
+	require.Equal(t, int32(0), read.Records[0].KeySchemaId)
+	require.Equal(t, int32(100), read.Records[0].ValueSchemaId)
+	require.Equal(t, int32(0), read.Records[1].ValueSchemaId)
+
+	message := read.Records[0].toMessage("other", true)
+	require.Equal(t, "other", *message.TopicPartition.Topic)
+	require.Equal(t, int32(2), message.TopicPartition.Partition)
+	require.Equal(t, []byte("key"), message.Key)
+	require.Equal(t, []byte{0, 0, 0, 0, 100, 1, 2}, message.Value)
+	require.Equal(t, int64(1700000000000), message.Timestamp.UnixMilli())
+	require.Equal(t, []ckafka.Header{{Key: "a", Value: []byte("1")}, {Key: "a"}}, message.Headers)
+
+	message = read.Records[1].toMessage("other", false)
+	require.Equal(t, ckafka.PartitionAny, message.TopicPartition.Partition)
+	require.Nil(t, message.Key)
+	require.Nil(t, message.Value)
+}
+
+func TestReadTopicArchive_Fail(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, data := range map[string]string{"invalid.json": "{", "version.json": `{"version": 2, "records": []}`} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+
+		_, err := ReadTopicArchive(path)
+		require.Error(t, err, name)
+	}
+}
+
+func TestTopicArchive_FetchSchemas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/schemas/ids/100" {
+			w.WriteHeader(http.StatusNotFound)
+			require.NoError(t, json.NewEncoder(w).Encode(srsdk.ErrorMessage{ErrorCode: 40403, Message: "Schema not found"}))
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(srsdk.SchemaString{Schema: "value"}))
+	}))
+	defer server.Close()
+
+	configuration := srsdk.NewConfiguration()
+	configuration.BasePath = server.URL
+	client := schemaregistry.NewClient(configuration)
+
+	topic := "topic"
+	archive := NewTopicArchive(topic, "string", "string")
+	archive.addMessage(&ckafka.Message{TopicPartition: ckafka.TopicPartition{Topic: &topic}, Value: []byte{0, 0, 0, 0, 100, 1}})
+	archive.addMessage(&ckafka.Message{TopicPartition: ckafka.TopicPartition{Topic: &topic}, Value: []byte{0, 0, 0, 0, 7, 1}})
+	require.True(t, archive.hasSchemas())
+
+	require.NoError(t, archive.FetchSchemas(client))
+	require.Equal(t, []archivedSchema{{Id: 100, Schema: "value"}}, archive.Schemas)
+	require.Equal(t, int32(100), archive.Records[0].ValueSchemaId)
+	require.Equal(t, int32(0), archive.Records[1].ValueSchemaId)
+
+	archive = NewTopicArchive(topic, "string", "avro")
+	archive.addMessage(&ckafka.Message{TopicPartition: ckafka.TopicPartition{Topic: &topic}, Value: []byte{0, 0, 0, 0, 7, 1}})
+	require.Error(t, archive.FetchSchemas(client))
+}
+
+func TestTopicArchive_RegisterSchemas(t *testing.T) {
+	registered := map[string][]srsdk.Schema{}
+	nextId := int32(200)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		subject := parts[1]
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodPost:
+			var req srsdk.RegisterSchemaRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			schema := srsdk.Schema{Subject: subject, Version: int32(len(registered[subject]) + 1), Id: nextId, Schema: req.Schema, References: req.References}
+			registered[subject] = append(registered[subject], schema)
+			nextId++
+			require.NoError(t, json.NewEncoder(w).Encode(srsdk.RegisterSchemaResponse{Id: schema.Id}))
+		case len(parts) == 3:
+			versions := make([]int32, len(registered[subject]))
+			for i, schema := range registered[subject] {
+				versions[i] = schema.Version
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(versions))
+		default:
+			for _, schema := range registered[subject] {
+				if parts[3] == strconv.Itoa(int(schema.Version)) {
+					require.NoError(t, json.NewEncoder(w).Encode(schema))
+				}
+			}
+		}
+	}))
+	defer server.Close()
+
+	configuration := srsdk.NewConfiguration()
+	configuration.BasePath = server.URL
+	client := schemaregistry.NewClient(configuration)
+
+	archive := &TopicArchive{
+		Version: topicArchiveVersion,
+		Schemas: []archivedSchema{
+			{Id: 1, Schema: "value", References: []srsdk.SchemaReference{{Name: "common", Subject: "common", Version: 3}}},
+			{Id: 2, Subject: "common", Version: 3, Schema: "common"},
+		},
+		Records: []archivedRecord{
+			{Value: []byte{0, 0, 0, 0, 1, 42}, ValueSchemaId: 1},
+			{Value: []byte{0, 0, 0, 0, 1, 43}, ValueSchemaId: 1},
+		},
+	}
+	require.NoError(t, archive.RegisterSchemas(client, "topic"))
+
+	require.Len(t, registered["common"], 1)
+	require.Len(t, registered["topic-value"], 1)
+	require.Equal(t, []srsdk.SchemaReference{{Name: "common", Subject: "common", Version: 1}}, registered["topic-value"][0].References)
+
+	for _, record := range archive.Records {
+		require.Equal(t, int32(201), record.ValueSchemaId)
+		require.Equal(t, int32(201), getSchemaId(record.Value))
+	}
+}
+
+func TestTopicArchive_RegisterSchemas_Missing(t *testing.T) {
+	archive := &TopicArchive{Records: []archivedRecord{{Value: []byte{0, 0, 0, 0, 1}, ValueSchemaId: 1}}}
+	require.EqualError(t, archive.RegisterSchemas(nil, "topic"), "schema 1 is missing from the topic archive")
+}
+
+func TestGetSchemaId(t *testing.T) {
+	require.Equal(t, int32(0), getSchemaId(nil))
+	require.Equal(t, int32(0), getSchemaId([]byte{0, 0, 0}))
+	require.Equal(t, int32(0), getSchemaId([]byte("plain text")))
+	require.Equal(t, int32(65536), getSchemaId([]byte{0, 0, 1, 0, 0, 5}))
+}
@@ -41,6 +41,12 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 
 	cmd.AddCommand(newClusterCommand(cfg, prerunner))
 
+	if cfg.IsCloudLogin() {
+		c := &ksqlCommand{pcmd.NewAuthenticatedCLICommand(cmd, prerunner)}
+		cmd.AddCommand(c.newExecuteCommand())
+		cmd.AddCommand(c.newShellCommand())
+	}
+
 	return cmd
 }
 
@@ -0,0 +1,133 @@
+package ksql
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/properties"
+)
+
+func (c *ksqlCommand) newExecuteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Execute ksqlDB statements.",
+		Long:  "Execute one or more ksqlDB statements, separated by semicolons. Queries print their rows as they arrive, and push queries run until their limit is reached or they are interrupted.",
+		Args:  cobra.NoArgs,
+		RunE:  c.execute,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `List the streams of ksqlDB cluster "lksqlc-12345".`,
+				Code: `confluent ksql execute --cluster lksqlc-12345 --sql "SHOW STREAMS;"`,
+			},
+			examples.Example{
+				Text: `Follow the rows of a stream from the beginning, until interrupted.`,
+				Code: `confluent ksql execute --cluster lksqlc-12345 --sql "SELECT * FROM pageviews EMIT CHANGES;" --property auto.offset.reset=earliest`,
+			},
+			examples.Example{
+				Text: `Execute the statements in file "setup.sql".`,
+				Code: "confluent ksql execute --cluster lksqlc-12345 --file setup.sql",
+			},
+		),
+	}
+
+	c.addClusterFlag(cmd)
+	cmd.Flags().String("sql", "", "The ksqlDB statements to execute.")
+	cmd.Flags().String("file", "", "The path to a file of ksqlDB statements to execute.")
+	addPropertyFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "sql", "ksql"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("cluster"))
+	cmd.MarkFlagsOneRequired("sql", "file")
+	cmd.MarkFlagsMutuallyExclusive("sql", "file")
+
+	return cmd
+}
+
+func (c *ksqlCommand) execute(cmd *cobra.Command, _ []string) error {
+	sql, err := cmd.Flags().GetString("sql")
+	if err != nil {
+		return err
+	}
+
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf(`failed to read file "%s": %w`, file, err)
+		}
+		sql = string(data)
+	}
+
+	statements := splitScript(sql)
+	if len(statements) == 0 {
+		return fmt.Errorf("no ksqlDB statements to execute")
+	}
+
+	streamsProperties, err := getProperties(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.getKsqlDBClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		if err := c.runStatement(cmd, client, statement, streamsProperties); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runStatement runs a statement and prints its result. An interrupt stops a query and prints the rows received so far.
+func (c *ksqlCommand) runStatement(cmd *cobra.Command, client *ksqlDBClient, statement string, streamsProperties map[string]string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if isQuery(statement) {
+		printer := newQueryPrinter(cmd)
+		if err := client.query(ctx, statement, streamsProperties, printer.printHeader, printer.printRow); err != nil {
+			return err
+		}
+		return printer.flush()
+	}
+
+	entities, err := client.execute(ctx, statement, streamsProperties)
+	if err != nil {
+		return err
+	}
+	return c.printEntities(cmd, entities)
+}
+
+func (c *ksqlCommand) addClusterFlag(cmd *cobra.Command) {
+	cmd.Flags().String("cluster", "", "ksqlDB cluster ID.")
+	pcmd.RegisterFlagCompletionFunc(cmd, "cluster", c.validArgsMultiple)
+}
+
+func addPropertyFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("property", nil, `A comma-separated list of ksqlDB properties ("key=value") for the statements, such as "auto.offset.reset=earliest".`)
+}
+
+func getProperties(cmd *cobra.Command) (map[string]string, error) {
+	property, err := cmd.Flags().GetStringSlice("property")
+	if err != nil {
+		return nil, err
+	}
+	return properties.ConfigFlagToMap(property)
+}
@@ -0,0 +1,103 @@
+package ksql
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+const (
+	shellPrompt             = "ksql> "
+	shellContinuationPrompt = "   -> "
+)
+
+func (c *ksqlCommand) newShellCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shell",
+		Short: "Start an interactive ksqlDB shell.",
+		Long:  "Start an interactive ksqlDB shell. Each statement is executed once it is terminated by a semicolon, and an interrupt stops a running query. Enter \"exit\" or \"quit\" to leave the shell.",
+		Args:  cobra.NoArgs,
+		RunE:  c.shell,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Start a shell for ksqlDB cluster "lksqlc-12345", which reads streams from the beginning.`,
+				Code: "confluent ksql shell --cluster lksqlc-12345 --property auto.offset.reset=earliest",
+			},
+		),
+	}
+
+	c.addClusterFlag(cmd)
+	addPropertyFlag(cmd)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("cluster"))
+
+	return cmd
+}
+
+func (c *ksqlCommand) shell(cmd *cobra.Command, _ []string) error {
+	streamsProperties, err := getProperties(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.getKsqlDBClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(cmd.InOrStdin())
+	var buffer strings.Builder
+	for {
+		prompt := shellPrompt
+		if buffer.Len() > 0 {
+			prompt = shellContinuationPrompt
+		}
+		fmt.Fprint(cmd.OutOrStdout(), prompt)
+
+		if !scanner.Scan() {
+			fmt.Fprintln(cmd.OutOrStdout())
+			return scanner.Err()
+		}
+
+		line := scanner.Text()
+		if buffer.Len() == 0 && isExit(line) {
+			return nil
+		}
+		if buffer.Len() == 0 && strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		buffer.WriteString(line + "\n")
+		statements, remainder := splitStatements(buffer.String())
+		if len(statements) == 0 {
+			continue
+		}
+
+		buffer.Reset()
+		if remainder != "" {
+			buffer.WriteString(remainder + "\n")
+		}
+
+		// An error ends the statement, but not the shell.
+		for _, statement := range statements {
+			if err := c.runStatement(cmd, client, statement, streamsProperties); err != nil {
+				output.ErrPrintf(c.Config.EnableColor, "Error: %v\n", err)
+				break
+			}
+		}
+	}
+}
+
+func isExit(line string) bool {
+	command := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(line), ";"))
+	return command == "exit" || command == "quit"
+}
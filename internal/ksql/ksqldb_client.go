@@ -0,0 +1,215 @@
+package ksql
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pauth "github.com/confluentinc/cli/v3/pkg/auth"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+)
+
+const (
+	ksqlContentType  = "application/vnd.ksql.v1+json"
+	queryContentType = "application/vnd.ksqlapi.delimited.v1"
+)
+
+// ksqlDBClient runs statements against the HTTP endpoint of a ksqlDB cluster.
+type ksqlDBClient struct {
+	httpClient *http.Client
+	endpoint   string
+	token      string
+}
+
+// ksqlDBError is the body of a failed request, or a row of a query which failed while streaming.
+type ksqlDBError struct {
+	Type      string `json:"@type"`
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+// queryHeader is the first row of a query, which describes the columns of the rows which follow.
+type queryHeader struct {
+	QueryId     string   `json:"queryId"`
+	ColumnNames []string `json:"columnNames"`
+	ColumnTypes []string `json:"columnTypes"`
+}
+
+func newKsqlDBClient(endpoint, token string) *ksqlDBClient {
+	return &ksqlDBClient{
+		httpClient: http.DefaultClient,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+	}
+}
+
+// getKsqlDBClient looks up the HTTP endpoint of the cluster passed to "--cluster", and authenticates with a data plane token.
+func (c *ksqlCommand) getKsqlDBClient(cmd *cobra.Command) (*ksqlDBClient, error) {
+	clusterId, err := cmd.Flags().GetString("cluster")
+	if err != nil {
+		return nil, err
+	}
+
+	environmentId, err := c.Context.EnvironmentId()
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := c.V2Client.DescribeKsqlCluster(clusterId, environmentId)
+	if err != nil {
+		return nil, errors.CatchKSQLNotFoundError(err, clusterId)
+	}
+
+	endpoint := cluster.Status.GetHttpEndpoint()
+	if endpoint == "" {
+		return nil, errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`ksqlDB cluster "%s" does not have an HTTP endpoint`, clusterId),
+			"Check the status of the cluster with `confluent ksql cluster describe`, and wait for it to be provisioned.",
+		)
+	}
+
+	state, err := c.Context.AuthenticatedState()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := pauth.GetDataplaneToken(state, c.Context.GetPlatformServer())
+	if err != nil {
+		return nil, err
+	}
+
+	return newKsqlDBClient(endpoint, token), nil
+}
+
+// execute runs a statement which is not a query with the "/ksql" endpoint, and returns the entities of the response.
+func (k *ksqlDBClient) execute(ctx context.Context, statement string, properties map[string]string) ([]json.RawMessage, error) {
+	body := map[string]any{
+		"ksql":              terminateStatement(statement),
+		"streamsProperties": properties,
+	}
+
+	res, err := k.post(ctx, "/ksql", ksqlContentType, body)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, readKsqlDBError(res)
+	}
+
+	var entities []json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&entities); err != nil {
+		return nil, fmt.Errorf("failed to parse ksqlDB response: %w", err)
+	}
+	return entities, nil
+}
+
+// query runs a pull or push query with the "/query-stream" endpoint, and calls onRow for each row as it arrives.
+// A push query runs until the context is cancelled or its limit is reached.
+func (k *ksqlDBClient) query(ctx context.Context, statement string, properties map[string]string, onHeader func(*queryHeader) error, onRow func([]any) error) error {
+	body := map[string]any{
+		"sql":        terminateStatement(statement),
+		"properties": properties,
+	}
+
+	res, err := k.post(ctx, "/query-stream", queryContentType, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return readKsqlDBError(res)
+	}
+
+	reader := bufio.NewReader(res.Body)
+	isHeader := true
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if err := handleQueryLine(line, isHeader, onHeader, onRow); err != nil {
+				return err
+			}
+			isHeader = false
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			// The query was stopped, for example by an interrupt.
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func handleQueryLine(line []byte, isHeader bool, onHeader func(*queryHeader) error, onRow func([]any) error) error {
+	// Rows are arrays, so an object with a message is an error which ended the query.
+	if line[0] == '{' {
+		ksqlDBErr := new(ksqlDBError)
+		if err := json.Unmarshal(line, ksqlDBErr); err == nil && ksqlDBErr.Message != "" {
+			return ksqlDBErr
+		}
+	}
+
+	if isHeader {
+		header := new(queryHeader)
+		if err := json.Unmarshal(line, header); err != nil {
+			return fmt.Errorf("failed to parse ksqlDB response: %w", err)
+		}
+		return onHeader(header)
+	}
+
+	var row []any
+	decoder := json.NewDecoder(bytes.NewReader(line))
+	decoder.UseNumber()
+	if err := decoder.Decode(&row); err != nil {
+		return fmt.Errorf("failed to parse ksqlDB response: %w", err)
+	}
+	return onRow(row)
+}
+
+func (k *ksqlDBClient) post(ctx context.Context, path, accept string, body any) (*http.Response, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.endpoint+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+k.token)
+
+	return k.httpClient.Do(req)
+}
+
+func readKsqlDBError(res *http.Response) error {
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	ksqlDBErr := new(ksqlDBError)
+	if err := json.Unmarshal(data, ksqlDBErr); err != nil || ksqlDBErr.Message == "" {
+		return fmt.Errorf("ksqlDB returned status %d: %s", res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return ksqlDBErr
+}
+
+func (e *ksqlDBError) Error() string {
+	return fmt.Sprintf("ksqlDB returned error %d: %s", e.ErrorCode, e.Message)
+}
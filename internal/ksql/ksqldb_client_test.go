@@ -0,0 +1,69 @@
+package ksql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKsqlDBClientExecute(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/ksql", r.URL.Path)
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "SHOW STREAMS;", body["ksql"])
+
+		_, _ = fmt.Fprint(w, `[{"@type":"streams","streams":[{"name":"PAGEVIEWS","topic":"pageviews"}]}]`)
+	}))
+	defer server.Close()
+
+	entities, err := newKsqlDBClient(server.URL, "token").execute(context.Background(), "SHOW STREAMS", nil)
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+}
+
+func TestKsqlDBClientExecuteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = fmt.Fprint(w, `{"@type":"statement_error","error_code":40001,"message":"line 1:1: Syntax Error"}`)
+	}))
+	defer server.Close()
+
+	_, err := newKsqlDBClient(server.URL, "token").execute(context.Background(), "SHOW", nil)
+	require.EqualError(t, err, "ksqlDB returned error 40001: line 1:1: Syntax Error")
+}
+
+func TestKsqlDBClientQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/query-stream", r.URL.Path)
+		_, _ = fmt.Fprint(w, `{"queryId":"q1","columnNames":["ID","NAME"],"columnTypes":["INTEGER","STRING"]}
+[1,"alice"]
+[2,null]
+{"@type":"generic_error","error_code":50000,"message":"query failed"}
+`)
+	}))
+	defer server.Close()
+
+	var header *queryHeader
+	var rows [][]any
+	err := newKsqlDBClient(server.URL, "token").query(context.Background(), "SELECT * FROM s", nil,
+		func(h *queryHeader) error {
+			header = h
+			return nil
+		},
+		func(row []any) error {
+			rows = append(rows, row)
+			return nil
+		},
+	)
+	require.EqualError(t, err, "ksqlDB returned error 50000: query failed")
+	require.Equal(t, []string{"ID", "NAME"}, header.ColumnNames)
+	require.Equal(t, [][]any{{json.Number("1"), "alice"}, {json.Number("2"), nil}}, rows)
+}
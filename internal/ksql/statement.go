@@ -0,0 +1,86 @@
+package ksql
+
+import (
+	"strings"
+)
+
+// splitStatements splits ksqlDB SQL into statements terminated by semicolons, ignoring semicolons in quotes and comments.
+// The unterminated text which follows the last semicolon is returned unchanged as the remainder.
+func splitStatements(sql string) ([]string, string) {
+	var statements []string
+	var statement strings.Builder
+	remainderStart := 0
+
+	var quote rune
+	inLineComment := false
+	inBlockComment := false
+
+	runes := []rune(sql)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch {
+		case inLineComment:
+			if r == '\n' {
+				inLineComment = false
+				statement.WriteRune(r)
+			}
+			continue
+		case inBlockComment:
+			if r == '*' && next == '/' {
+				inBlockComment = false
+				i++
+			}
+			continue
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+		case r == '-' && next == '-':
+			inLineComment = true
+			continue
+		case r == '/' && next == '*':
+			inBlockComment = true
+			i++
+			continue
+		case r == ';':
+			if s := strings.TrimSpace(statement.String()); s != "" {
+				statements = append(statements, s)
+			}
+			statement.Reset()
+			remainderStart = i + 1
+			continue
+		}
+
+		statement.WriteRune(r)
+	}
+
+	return statements, strings.TrimSpace(string(runes[remainderStart:]))
+}
+
+// isQuery returns true for statements which return rows, and so must be run with the "/query-stream" endpoint.
+func isQuery(statement string) bool {
+	fields := strings.Fields(statement)
+	return len(fields) > 0 && strings.EqualFold(fields[0], "SELECT")
+}
+
+// terminateStatement adds the semicolon which ksqlDB requires at the end of a statement.
+func terminateStatement(statement string) string {
+	return strings.TrimSuffix(strings.TrimSpace(statement), ";") + ";"
+}
+
+// splitScript splits ksqlDB SQL into statements, where the last statement does not need to be terminated.
+func splitScript(sql string) []string {
+	statements, remainder := splitStatements(sql)
+	if remainder != "" {
+		last, _ := splitStatements(remainder + "\n;")
+		statements = append(statements, last...)
+	}
+	return statements
+}
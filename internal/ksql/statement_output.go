@@ -0,0 +1,261 @@
+package ksql
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tidwall/pretty"
+
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type sourceOut struct {
+	Name        string `human:"Name" serialized:"name"`
+	Topic       string `human:"Topic" serialized:"topic"`
+	KeyFormat   string `human:"Key Format" serialized:"key_format"`
+	ValueFormat string `human:"Value Format" serialized:"value_format"`
+	IsWindowed  bool   `human:"Windowed" serialized:"is_windowed"`
+}
+
+type queryOut struct {
+	Id        string `human:"ID" serialized:"id"`
+	QueryType string `human:"Query Type" serialized:"query_type"`
+	State     string `human:"State" serialized:"state"`
+	Sinks     string `human:"Sinks" serialized:"sinks"`
+}
+
+type topicOut struct {
+	Name       string `human:"Name" serialized:"name"`
+	Partitions int    `human:"Partitions" serialized:"partitions"`
+}
+
+// ksqlDBEntity is an entity in the response of the "/ksql" endpoint. Only the fields which are printed are decoded.
+type ksqlDBEntity struct {
+	Type          string `json:"@type"`
+	CommandStatus struct {
+		Message string `json:"message"`
+	} `json:"commandStatus"`
+	Streams []ksqlDBSource `json:"streams"`
+	Tables  []ksqlDBSource `json:"tables"`
+	Queries []struct {
+		Id        string   `json:"id"`
+		QueryType string   `json:"queryType"`
+		State     string   `json:"state"`
+		Sinks     []string `json:"sinks"`
+	} `json:"queries"`
+	Topics []struct {
+		Name        string `json:"name"`
+		ReplicaInfo []int  `json:"replicaInfo"`
+	} `json:"topics"`
+	Warnings []struct {
+		Message string `json:"message"`
+	} `json:"warnings"`
+}
+
+type ksqlDBSource struct {
+	Name        string `json:"name"`
+	Topic       string `json:"topic"`
+	KeyFormat   string `json:"keyFormat"`
+	ValueFormat string `json:"valueFormat"`
+	IsWindowed  bool   `json:"isWindowed"`
+}
+
+// printEntities prints the response of a statement which is not a query. Streams, tables, queries, topics, and command
+// statuses are printed in the human-readable format, and any other entity is printed as JSON.
+func (c *ksqlCommand) printEntities(cmd *cobra.Command, rawEntities []json.RawMessage) error {
+	if output.GetFormat(cmd).IsSerialized() {
+		entities := make([]any, len(rawEntities))
+		for i, rawEntity := range rawEntities {
+			decoder := json.NewDecoder(bytes.NewReader(rawEntity))
+			decoder.UseNumber()
+			if err := decoder.Decode(&entities[i]); err != nil {
+				return fmt.Errorf("failed to parse ksqlDB response: %w", err)
+			}
+		}
+		return output.SerializedOutput(cmd, entities)
+	}
+
+	for _, rawEntity := range rawEntities {
+		entity := new(ksqlDBEntity)
+		if err := json.Unmarshal(rawEntity, entity); err != nil {
+			return fmt.Errorf("failed to parse ksqlDB response: %w", err)
+		}
+
+		if err := c.printEntity(cmd, entity, rawEntity); err != nil {
+			return err
+		}
+
+		for _, warning := range entity.Warnings {
+			output.ErrPrintf(c.Config.EnableColor, "[WARN] %s\n", warning.Message)
+		}
+	}
+
+	return nil
+}
+
+func (c *ksqlCommand) printEntity(cmd *cobra.Command, entity *ksqlDBEntity, rawEntity json.RawMessage) error {
+	list := output.NewList(cmd)
+	switch entity.Type {
+	case "currentStatus":
+		if output.GetFormat(cmd).IsDelimited() {
+			output.ErrPrintln(c.Config.EnableColor, entity.CommandStatus.Message)
+			return nil
+		}
+		output.Println(c.Config.EnableColor, entity.CommandStatus.Message)
+		return nil
+	case "streams":
+		for _, stream := range entity.Streams {
+			list.Add(newSourceOut(stream))
+		}
+	case "tables":
+		for _, table := range entity.Tables {
+			list.Add(newSourceOut(table))
+		}
+	case "queries":
+		for _, query := range entity.Queries {
+			list.Add(&queryOut{
+				Id:        query.Id,
+				QueryType: query.QueryType,
+				State:     query.State,
+				Sinks:     strings.Join(query.Sinks, ", "),
+			})
+		}
+	case "kafka_topics":
+		for _, topic := range entity.Topics {
+			list.Add(&topicOut{
+				Name:       topic.Name,
+				Partitions: len(topic.ReplicaInfo),
+			})
+		}
+	default:
+		if err := output.ValidateNotDelimited(cmd); err != nil {
+			return err
+		}
+		output.Println(false, string(pretty.Pretty(rawEntity)))
+		return nil
+	}
+	return list.Print()
+}
+
+func newSourceOut(source ksqlDBSource) *sourceOut {
+	return &sourceOut{
+		Name:        source.Name,
+		Topic:       source.Topic,
+		KeyFormat:   source.KeyFormat,
+		ValueFormat: source.ValueFormat,
+		IsWindowed:  source.IsWindowed,
+	}
+}
+
+// queryPrinter prints the rows of a query. Rows are printed as they arrive in the human-readable, CSV, TSV, and NDJSON
+// formats, so push queries can be followed. The other formats print a single document once the query ends.
+type queryPrinter struct {
+	cmd     *cobra.Command
+	format  output.Format
+	writer  io.Writer
+	csv     *csv.Writer
+	columns []string
+	rows    []map[string]any
+}
+
+func newQueryPrinter(cmd *cobra.Command) *queryPrinter {
+	printer := &queryPrinter{
+		cmd:    cmd,
+		format: output.GetFormat(cmd),
+		writer: cmd.OutOrStdout(),
+		rows:   []map[string]any{},
+	}
+
+	if printer.format.IsDelimited() {
+		printer.csv = csv.NewWriter(printer.writer)
+		if printer.format == output.TSV {
+			printer.csv.Comma = '\t'
+		}
+	}
+
+	return printer
+}
+
+func (p *queryPrinter) printHeader(header *queryHeader) error {
+	p.columns = header.ColumnNames
+
+	switch {
+	case p.format == output.Human:
+		line := strings.Join(p.columns, " | ")
+		_, err := fmt.Fprintf(p.writer, "%s\n%s\n", line, strings.Repeat("-", len(line)))
+		return err
+	case p.format.IsDelimited():
+		return p.writeDelimited(p.columns)
+	}
+	return nil
+}
+
+func (p *queryPrinter) printRow(row []any) error {
+	switch {
+	case p.format == output.Human:
+		_, err := fmt.Fprintln(p.writer, strings.Join(formatColumns(row), " | "))
+		return err
+	case p.format.IsDelimited():
+		return p.writeDelimited(formatColumns(row))
+	}
+
+	object := make(map[string]any, len(row))
+	for i, value := range row {
+		if i < len(p.columns) {
+			object[p.columns[i]] = value
+		}
+	}
+
+	if p.format == output.NDJSON {
+		out, err := json.Marshal(object)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(p.writer, string(out))
+		return err
+	}
+
+	p.rows = append(p.rows, object)
+	return nil
+}
+
+// flush prints the rows of formats which cannot be printed until the query ends.
+func (p *queryPrinter) flush() error {
+	if p.format == output.Human || p.format == output.NDJSON || p.format.IsDelimited() {
+		return nil
+	}
+	return output.SerializedOutput(p.cmd, p.rows)
+}
+
+func (p *queryPrinter) writeDelimited(record []string) error {
+	if err := p.csv.Write(record); err != nil {
+		return err
+	}
+	p.csv.Flush()
+	return p.csv.Error()
+}
+
+func formatColumns(row []any) []string {
+	columns := make([]string, len(row))
+	for i, value := range row {
+		switch value := value.(type) {
+		case string:
+			columns[i] = value
+		case json.Number:
+			columns[i] = value.String()
+		default:
+			out, err := json.Marshal(value)
+			if err != nil {
+				columns[i] = fmt.Sprint(value)
+			} else {
+				columns[i] = string(out)
+			}
+		}
+	}
+	return columns
+}
@@ -0,0 +1,31 @@
+package ksql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitStatements(t *testing.T) {
+	sql := `CREATE STREAM s (id INT) WITH (kafka_topic='a;b', value_format='JSON');
+-- a comment; with a semicolon
+SHOW STREAMS; /* another; comment */ SELECT * FROM s`
+
+	statements, remainder := splitStatements(sql)
+	require.Equal(t, []string{
+		"CREATE STREAM s (id INT) WITH (kafka_topic='a;b', value_format='JSON')",
+		"SHOW STREAMS",
+	}, statements)
+	require.Equal(t, "/* another; comment */ SELECT * FROM s", remainder)
+}
+
+func TestSplitScript(t *testing.T) {
+	require.Equal(t, []string{"SHOW TOPICS", "SELECT * FROM s"}, splitScript("SHOW TOPICS;\nSELECT * FROM s -- until interrupted"))
+	require.Empty(t, splitScript("-- nothing to run\n"))
+}
+
+func TestIsQuery(t *testing.T) {
+	require.True(t, isQuery("select * from s emit changes"))
+	require.False(t, isQuery("SHOW QUERIES"))
+	require.False(t, isQuery(""))
+}
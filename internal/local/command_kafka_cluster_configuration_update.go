@@ -63,6 +63,7 @@ func (c *command) configurationUpdate(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(broker.ConfigOut))
 	for _, config := range configs.Data {
 		list.Add(&broker.ConfigOut{
 			Name:  config.Name,
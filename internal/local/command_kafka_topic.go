@@ -26,6 +26,8 @@ func (c *command) newKafkaTopicCommand() *cobra.Command {
 	cmd.AddCommand(c.newKafkaTopicCreateCommand())
 	cmd.AddCommand(c.newKafkaTopicDeleteCommand())
 	cmd.AddCommand(c.newKafkaTopicDescribeCommand())
+	cmd.AddCommand(c.newKafkaTopicExportCommand())
+	cmd.AddCommand(c.newKafkaTopicImportCommand())
 	cmd.AddCommand(c.newKafkaTopicListCommand())
 	cmd.AddCommand(c.newKafkaTopicProduceCommand())
 	cmd.AddCommand(c.newKafkaTopicUpdateCommand())
@@ -127,9 +127,14 @@ func (c *command) kafkaTopicConsume(cmd *cobra.Command, args []string) error {
 }
 
 func newOnPremConsumer(cmd *cobra.Command, bootstrap string) (*ckafka.Consumer, error) {
-	group, err := cmd.Flags().GetString("group")
-	if err != nil {
-		return nil, err
+	// `local kafka topic export` has no "--group" flag, and always uses a new group.
+	var group string
+	if cmd.Flags().Changed("group") {
+		var err error
+		group, err = cmd.Flags().GetString("group")
+		if err != nil {
+			return nil, err
+		}
 	}
 	if group == "" {
 		group = fmt.Sprintf("confluent_cli_consumer_%s", uuid.New())
@@ -143,6 +148,7 @@ func newOnPremConsumer(cmd *cobra.Command, bootstrap string) (*ckafka.Consumer,
 		"bootstrap.servers":                     bootstrap,
 		"partition.assignment.strategy":         "cooperative-sticky",
 		"security.protocol":                     "PLAINTEXT",
+		"enable.partition.eof":                  true,
 	}
 
 	configFile, err := cmd.Flags().GetString("config-file")
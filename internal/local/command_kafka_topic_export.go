@@ -0,0 +1,92 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/confluentinc/cli/v3/internal/kafka"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *command) newKafkaTopicExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <topic>",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.kafkaTopicExport,
+		Short: "Export the messages of a Kafka topic to a file.",
+		Long:  "Export every message of a Kafka topic, from the beginning of each partition to its end, to a file which can be loaded with `confluent local kafka topic import` or `confluent kafka topic import`.",
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Export topic "test" to "test.json".`,
+				Code: "confluent local kafka topic export test --file test.json",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "The file to write the exported messages to.")
+	cmd.Flags().Duration("timeout", 0, `Exit with an error if the end of every partition is not reached within this duration (for example, "30s").`)
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the consumer client.`)
+	pcmd.AddConsumerConfigFileFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) kafkaTopicExport(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	timeout, err := cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	if c.Config.LocalPorts == nil {
+		return errors.NewErrorWithSuggestions(errors.FailedToReadPortsErrorMsg, errors.FailedToReadPortsSuggestions)
+	}
+	consumer, err := newOnPremConsumer(cmd, c.getPlaintextBootstrapServers())
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateConsumerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	log.CliLogger.Tracef("Create consumer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromConsumer(consumer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	topicName := args[0]
+	if err := kafka.ValidateTopic(adminClient, topicName); err != nil {
+		return err
+	}
+
+	archive := kafka.NewTopicArchive(topicName, "string", "string")
+	if err := kafka.ExportTopic(consumer, archive, cmd.ErrOrStderr(), timeout); err != nil {
+		return err
+	}
+
+	if err := archive.Write(file); err != nil {
+		return err
+	}
+
+	output.Printf(c.Config.EnableColor, "Exported %d message(s) from topic \"%s\" to \"%s\".\n", len(archive.Records), topicName, file)
+	return nil
+}
@@ -0,0 +1,98 @@
+package local
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	ckafka "github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/confluentinc/cli/v3/internal/kafka"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/log"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func (c *command) newKafkaTopicImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <topic>",
+		Args:  cobra.ExactArgs(1),
+		RunE:  c.kafkaTopicImport,
+		Short: "Import messages to a Kafka topic from a file.",
+		Long:  "Import messages to a Kafka topic from a file written by `confluent local kafka topic export` or `confluent kafka topic export`.\n\nMessages keep their keys, values, headers, and timestamps, and are produced to the partition they were exported from unless `--ignore-partitions` is set. Schema IDs are left unchanged, since schemas cannot be registered with a local Kafka cluster.",
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Import the messages in "test.json" to topic "test".`,
+				Code: "confluent local kafka topic import test --file test.json",
+			},
+		),
+	}
+
+	cmd.Flags().String("file", "", "The file to read the imported messages from.")
+	cmd.Flags().Bool("ignore-partitions", false, "Let the producer choose the partition of each message, rather than using the partition it was exported from.")
+	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
+	pcmd.AddProducerConfigFileFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "json"))
+	cobra.CheckErr(cmd.MarkFlagFilename("config-file", "avsc", "json"))
+
+	cmd.MarkFlagsMutuallyExclusive("config", "config-file")
+
+	return cmd
+}
+
+func (c *command) kafkaTopicImport(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+
+	ignorePartitions, err := cmd.Flags().GetBool("ignore-partitions")
+	if err != nil {
+		return err
+	}
+
+	archive, err := kafka.ReadTopicArchive(file)
+	if err != nil {
+		return err
+	}
+
+	if c.Config.LocalPorts == nil {
+		return errors.NewErrorWithSuggestions(errors.FailedToReadPortsErrorMsg, errors.FailedToReadPortsSuggestions)
+	}
+	producer, err := newOnPremProducer(cmd, c.getPlaintextBootstrapServers())
+	if err != nil {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(errors.FailedToCreateProducerErrorMsg, err),
+			errors.OnPremConfigGuideSuggestions,
+		)
+	}
+	defer producer.Close()
+	log.CliLogger.Tracef("Create producer succeeded")
+
+	adminClient, err := ckafka.NewAdminClientFromProducer(producer)
+	if err != nil {
+		return fmt.Errorf(errors.FailedToCreateAdminClientErrorMsg, err)
+	}
+	defer adminClient.Close()
+
+	topicName := args[0]
+	if err := kafka.ValidateTopic(adminClient, topicName); err != nil {
+		return err
+	}
+
+	if len(archive.Schemas) > 0 {
+		output.ErrPrintf(c.Config.EnableColor, "[WARN] Messages in \"%s\" are encoded with schemas, which cannot be registered with a local Kafka cluster. Their schema IDs are left unchanged.\n", file)
+	}
+
+	count, err := kafka.ImportTopic(producer, archive, topicName, !ignorePartitions)
+	if err != nil {
+		return fmt.Errorf("imported %d of %d message(s) before failing: %w", count, len(archive.Records), err)
+	}
+
+	output.Printf(c.Config.EnableColor, "Imported %d message(s) from \"%s\" to topic \"%s\".\n", count, file, topicName)
+	return nil
+}
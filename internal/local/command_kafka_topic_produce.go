@@ -21,7 +21,7 @@ func (c *command) newKafkaTopicProduceCommand() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		RunE:  c.kafkaTopicProduce,
 		Short: "Produce messages to a Kafka topic.",
-		Long:  "Produce messages to a Kafka topic. Configuration and command guide: https://docs.confluent.io/confluent-cli/current/cp-produce-consume.html.\n\nWhen using this command, you cannot modify the message header, and the message header will not be printed out.",
+		Long:  "Produce messages to a Kafka topic. Configuration and command guide: https://docs.confluent.io/confluent-cli/current/cp-produce-consume.html.\n\nTo set message headers, partitions, or timestamps, use `--input-format json` and pass one JSON object per line.",
 		Example: examples.BuildExampleString(
 			examples.Example{
 				Text: `Produce message to topic "test" providing key.`,
@@ -32,6 +32,7 @@ func (c *command) newKafkaTopicProduceCommand() *cobra.Command {
 
 	cmd.Flags().Bool("parse-key", false, "Parse key from the message.")
 	cmd.Flags().String("delimiter", ":", "The delimiter separating each key and value.")
+	pcmd.AddInputFormatFlag(cmd)
 	cmd.Flags().StringSlice("config", nil, `A comma-separated list of configuration overrides ("key=value") for the producer client.`)
 	pcmd.AddProducerConfigFileFlag(cmd)
 
@@ -46,6 +47,9 @@ func (c *command) kafkaTopicProduce(cmd *cobra.Command, args []string) error {
 	if c.Config.LocalPorts == nil {
 		return errors.NewErrorWithSuggestions(errors.FailedToReadPortsErrorMsg, errors.FailedToReadPortsSuggestions)
 	}
+	if err := kafka.ValidateInputFormat(cmd); err != nil {
+		return err
+	}
 	producer, err := newOnPremProducer(cmd, c.getPlaintextBootstrapServers())
 	if err != nil {
 		return errors.NewErrorWithSuggestions(
@@ -0,0 +1,128 @@
+package manifest
+
+import (
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+
+	"github.com/confluentinc/cli/v3/pkg/ccloudv2"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+// kafkaCluster reads and changes the topics and ACLs of a Kafka cluster, through the Kafka REST API of either
+// Confluent Cloud or Confluent Platform.
+type kafkaCluster interface {
+	id() string
+	listTopics() (map[string]topicState, error)
+	listTopicConfigs(topic string) (map[string]string, error)
+	listAcls() ([]Acl, error)
+	createTopic(topic *Topic) error
+	updateTopicConfigs(topic *Topic) error
+	createAcl(acl *Acl) error
+	deleteAcl(acl *Acl) error
+}
+
+type cloudKafkaCluster struct {
+	client *ccloudv2.KafkaRestClient
+}
+
+func (c *cloudKafkaCluster) id() string {
+	return c.client.ClusterId
+}
+
+func (c *cloudKafkaCluster) listTopics() (map[string]topicState, error) {
+	topics, err := c.client.ListKafkaTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string]topicState)
+	for _, topic := range topics.Data {
+		states[topic.TopicName] = topicState{Partitions: topic.PartitionsCount, ReplicationFactor: topic.ReplicationFactor}
+	}
+	return states, nil
+}
+
+func (c *cloudKafkaCluster) listTopicConfigs(topic string) (map[string]string, error) {
+	configs, err := c.client.ListKafkaTopicConfigs(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	for _, config := range configs {
+		if value := config.Value.Get(); value != nil {
+			values[config.Name] = *value
+		}
+	}
+	return values, nil
+}
+
+func (c *cloudKafkaCluster) listAcls() ([]Acl, error) {
+	acls, err := c.client.ListKafkaAcls()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]Acl, len(acls))
+	for i, acl := range acls {
+		list[i] = Acl{
+			Principal:    acl.Principal,
+			Permission:   acl.Permission,
+			Operation:    acl.Operation,
+			ResourceType: string(acl.ResourceType),
+			ResourceName: acl.ResourceName,
+			PatternType:  acl.PatternType,
+			Host:         acl.Host,
+		}
+	}
+	return list, nil
+}
+
+func (c *cloudKafkaCluster) createTopic(topic *Topic) error {
+	configs := make([]kafkarestv3.CreateTopicRequestDataConfigs, 0, len(topic.Configs))
+	for name, value := range topic.Configs {
+		value := value
+		configs = append(configs, kafkarestv3.CreateTopicRequestDataConfigs{Name: name, Value: *kafkarestv3.NewNullableString(&value)})
+	}
+
+	data := kafkarestv3.CreateTopicRequestData{TopicName: topic.Name, Configs: &configs}
+	if topic.Partitions != 0 {
+		data.PartitionsCount = &topic.Partitions
+	}
+	if topic.ReplicationFactor != 0 {
+		data.ReplicationFactor = &topic.ReplicationFactor
+	}
+
+	_, httpResp, err := c.client.CreateKafkaTopic(data)
+	return kafkarest.NewError(c.client.GetUrl(), err, httpResp)
+}
+
+func (c *cloudKafkaCluster) updateTopicConfigs(topic *Topic) error {
+	configs := make([]kafkarestv3.AlterConfigBatchRequestDataData, 0, len(topic.Configs))
+	for name, value := range topic.Configs {
+		value := value
+		configs = append(configs, kafkarestv3.AlterConfigBatchRequestDataData{Name: name, Value: *kafkarestv3.NewNullableString(&value)})
+	}
+
+	httpResp, err := c.client.UpdateKafkaTopicConfigBatch(topic.Name, kafkarestv3.AlterConfigBatchRequestData{Data: configs})
+	return kafkarest.NewError(c.client.GetUrl(), err, httpResp)
+}
+
+func (c *cloudKafkaCluster) createAcl(acl *Acl) error {
+	return c.client.CreateKafkaAcls(cloudAclRequest(acl))
+}
+
+func (c *cloudKafkaCluster) deleteAcl(acl *Acl) error {
+	return c.client.DeleteKafkaAcl(cloudAclRequest(acl))
+}
+
+func cloudAclRequest(acl *Acl) kafkarestv3.CreateAclRequestData {
+	return kafkarestv3.CreateAclRequestData{
+		ResourceType: kafkarestv3.AclResourceType(acl.ResourceType),
+		ResourceName: acl.ResourceName,
+		PatternType:  acl.PatternType,
+		Principal:    acl.Principal,
+		Host:         acl.Host,
+		Operation:    acl.Operation,
+		Permission:   acl.Permission,
+	}
+}
@@ -0,0 +1,134 @@
+package manifest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/antihax/optional"
+
+	"github.com/confluentinc/kafka-rest-sdk-go/kafkarestv3"
+
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+)
+
+type onPremKafkaCluster struct {
+	client    *kafkarestv3.APIClient
+	context   context.Context
+	clusterId string
+}
+
+func (c *onPremKafkaCluster) id() string {
+	return c.clusterId
+}
+
+func (c *onPremKafkaCluster) listTopics() (map[string]topicState, error) {
+	topics, httpResp, err := c.client.TopicV3Api.ListKafkaTopics(c.context, c.clusterId)
+	if err != nil {
+		return nil, c.newError(err, httpResp)
+	}
+
+	states := make(map[string]topicState)
+	for _, topic := range topics.Data {
+		states[topic.TopicName] = topicState{Partitions: topic.PartitionsCount, ReplicationFactor: topic.ReplicationFactor}
+	}
+	return states, nil
+}
+
+func (c *onPremKafkaCluster) listTopicConfigs(topic string) (map[string]string, error) {
+	configs, httpResp, err := c.client.ConfigsV3Api.ListKafkaTopicConfigs(c.context, c.clusterId, topic)
+	if err != nil {
+		return nil, c.newError(err, httpResp)
+	}
+
+	values := make(map[string]string)
+	for _, config := range configs.Data {
+		if config.Value != nil {
+			values[config.Name] = *config.Value
+		}
+	}
+	return values, nil
+}
+
+func (c *onPremKafkaCluster) listAcls() ([]Acl, error) {
+	acls, httpResp, err := c.client.ACLV3Api.GetKafkaAcls(c.context, c.clusterId, &kafkarestv3.GetKafkaAclsOpts{})
+	if err != nil {
+		return nil, c.newError(err, httpResp)
+	}
+
+	list := make([]Acl, len(acls.Data))
+	for i, acl := range acls.Data {
+		list[i] = Acl{
+			Principal:    acl.Principal,
+			Permission:   acl.Permission,
+			Operation:    acl.Operation,
+			ResourceType: string(acl.ResourceType),
+			ResourceName: acl.ResourceName,
+			PatternType:  acl.PatternType,
+			Host:         acl.Host,
+		}
+	}
+	return list, nil
+}
+
+func (c *onPremKafkaCluster) createTopic(topic *Topic) error {
+	configs := make([]kafkarestv3.CreateTopicRequestDataConfigs, 0, len(topic.Configs))
+	for name, value := range topic.Configs {
+		value := value
+		configs = append(configs, kafkarestv3.CreateTopicRequestDataConfigs{Name: name, Value: &value})
+	}
+	data := kafkarestv3.CreateTopicRequestData{
+		TopicName:         topic.Name,
+		PartitionsCount:   topic.Partitions,
+		ReplicationFactor: topic.ReplicationFactor,
+		Configs:           configs,
+	}
+
+	_, httpResp, err := c.client.TopicV3Api.CreateKafkaTopic(c.context, c.clusterId, &kafkarestv3.CreateKafkaTopicOpts{CreateTopicRequestData: optional.NewInterface(data)})
+	return c.newError(err, httpResp)
+}
+
+func (c *onPremKafkaCluster) updateTopicConfigs(topic *Topic) error {
+	configs := make([]kafkarestv3.AlterConfigBatchRequestDataData, 0, len(topic.Configs))
+	for name, value := range topic.Configs {
+		value := value
+		configs = append(configs, kafkarestv3.AlterConfigBatchRequestDataData{Name: name, Value: &value})
+	}
+	data := kafkarestv3.AlterConfigBatchRequestData{Data: configs}
+
+	httpResp, err := c.client.ConfigsV3Api.UpdateKafkaTopicConfigBatch(c.context, c.clusterId, topic.Name, &kafkarestv3.UpdateKafkaTopicConfigBatchOpts{AlterConfigBatchRequestData: optional.NewInterface(data)})
+	return c.newError(err, httpResp)
+}
+
+func (c *onPremKafkaCluster) createAcl(acl *Acl) error {
+	data := kafkarestv3.CreateAclRequestData{
+		ResourceType: kafkarestv3.AclResourceType(acl.ResourceType),
+		ResourceName: acl.ResourceName,
+		PatternType:  acl.PatternType,
+		Principal:    acl.Principal,
+		Host:         acl.Host,
+		Operation:    acl.Operation,
+		Permission:   acl.Permission,
+	}
+
+	httpResp, err := c.client.ACLV3Api.CreateKafkaAcls(c.context, c.clusterId, &kafkarestv3.CreateKafkaAclsOpts{CreateAclRequestData: optional.NewInterface(data)})
+	return c.newError(err, httpResp)
+}
+
+func (c *onPremKafkaCluster) deleteAcl(acl *Acl) error {
+	opts := &kafkarestv3.DeleteKafkaAclsOpts{
+		ResourceType: optional.NewInterface(kafkarestv3.AclResourceType(acl.ResourceType)),
+		ResourceName: optional.NewString(acl.ResourceName),
+		PatternType:  optional.NewString(acl.PatternType),
+		Principal:    optional.NewString(acl.Principal),
+		Host:         optional.NewString(acl.Host),
+		Operation:    optional.NewString(acl.Operation),
+		Permission:   optional.NewString(acl.Permission),
+	}
+
+	_, httpResp, err := c.client.ACLV3Api.DeleteKafkaAcls(c.context, c.clusterId, opts)
+	return c.newError(err, httpResp)
+}
+
+func (c *onPremKafkaCluster) newError(err error, httpResp *http.Response) error {
+	return kafkarest.NewError(c.client.GetConfig().BasePath, err, httpResp)
+}
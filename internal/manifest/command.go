@@ -0,0 +1,163 @@
+package manifest
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/mds-sdk-go-public/mdsv1"
+
+	"github.com/confluentinc/cli/v3/internal/kafka"
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/kafkarest"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+type command struct {
+	*pcmd.AuthenticatedCLICommand
+}
+
+// Role bindings can only be managed on Confluent Platform, where they are read and changed through MDS in the scope
+// of the Kafka cluster. Confluent Cloud role bindings are scoped to an organization and environment, and are managed
+// with `confluent iam rbac role-binding`.
+func newCommand(cfg *config.Config, cmd *cobra.Command, prerunner pcmd.PreRunner) *command {
+	c := new(command)
+
+	cmd.Flags().StringP("file", "f", "", "The YAML manifest of topics, ACLs, and role bindings.")
+	cmd.Flags().Bool("prune", false, "Delete the ACLs and role bindings of the principals in the manifest which are not listed in the manifest.")
+
+	if cfg.IsCloudLogin() {
+		cmd.Annotations = map[string]string{pcmd.RunRequirement: pcmd.RequireNonAPIKeyCloudLogin}
+		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedCLICommand(cmd, prerunner)
+
+		pcmd.AddClusterFlag(cmd, c.AuthenticatedCLICommand)
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		cmd.Annotations = map[string]string{pcmd.RunRequirement: pcmd.RequireNonCloudLogin}
+		c.AuthenticatedCLICommand = pcmd.NewAuthenticatedWithMDSCLICommand(cmd, prerunner)
+		c.PersistentPreRunE = prerunner.InitializeOnPremKafkaRest(c.AuthenticatedCLICommand)
+
+		cmd.Flags().AddFlagSet(pcmd.OnPremKafkaRestSet())
+	}
+
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagRequired("file"))
+	cobra.CheckErr(cmd.MarkFlagFilename("file", "yaml", "yml"))
+
+	return c
+}
+
+// urlExample returns the flag which examples pass to reach the Kafka REST Proxy of Confluent Platform.
+func urlExample(cfg *config.Config) string {
+	if cfg.IsCloudLogin() {
+		return ""
+	}
+	return " --url http://localhost:8090/kafka"
+}
+
+// plan reads the manifest and returns the changes needed to apply it, along with the clients needed to make them.
+func (c *command) plan(cmd *cobra.Command) ([]*change, kafkaCluster, error) {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prune, err := cmd.Flags().GetBool("prune")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	manifest, err := ReadManifest(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(manifest.RoleBindings) > 0 {
+		if c.Config.IsCloudLogin() {
+			return nil, nil, errors.NewErrorWithSuggestions(
+				"role bindings in manifests are only supported on Confluent Platform",
+				"Remove the role bindings from the manifest, and manage them with `confluent iam rbac role-binding` instead.",
+			)
+		}
+		if c.MDSClient == nil {
+			return nil, nil, new(errors.NotLoggedInError)
+		}
+	}
+
+	cluster, err := c.getKafkaCluster(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state, err := c.getClusterState(cluster, manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := computePlan(manifest, state, prune)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return plan, cluster, nil
+}
+
+func (c *command) getKafkaCluster(cmd *cobra.Command) (kafkaCluster, error) {
+	if c.Config.IsCloudLogin() {
+		kafkaREST, err := c.GetKafkaREST()
+		if err != nil {
+			return nil, err
+		}
+		return &cloudKafkaCluster{client: kafkaREST.CloudClient}, nil
+	}
+
+	url, err := cmd.Flags().GetString("url")
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		url = os.Getenv("CONFLUENT_REST_URL")
+	}
+	if url == "" {
+		return nil, errors.NewErrorWithSuggestions(errors.KafkaRestUrlNotFoundErrorMsg, errors.KafkaRestUrlNotFoundSuggestions)
+	}
+
+	kafkaREST, err := c.GetKafkaREST()
+	if err != nil {
+		return nil, err
+	}
+	kafka.SetServerURL(cmd, kafkaREST.Client, url)
+
+	clusters, httpResp, err := kafkaREST.Client.ClusterV3Api.ClustersGet(kafkaREST.Context)
+	if err != nil {
+		return nil, kafkarest.NewError(kafkaREST.Client.GetConfig().BasePath, err, httpResp)
+	}
+	if len(clusters.Data) == 0 {
+		return nil, errors.NewErrorWithSuggestions(errors.NoClustersFoundErrorMsg, errors.NoClustersFoundSuggestions)
+	}
+
+	return &onPremKafkaCluster{client: kafkaREST.Client, context: kafkaREST.Context, clusterId: clusters.Data[0].ClusterId}, nil
+}
+
+func (c *command) mdsContext() context.Context {
+	return context.WithValue(context.Background(), mdsv1.ContextAccessToken, c.Context.GetAuthToken())
+}
+
+func (c *command) printPlan(cmd *cobra.Command, plan []*change) error {
+	if output.GetFormat(cmd) == output.Human && len(plan) == 0 {
+		output.Println(c.Config.EnableColor, "No changes.")
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	for _, change := range plan {
+		list.Add(change.toOutput())
+	}
+	list.Sort(false)
+	return list.Print()
+}
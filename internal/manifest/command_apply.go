@@ -0,0 +1,67 @@
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+)
+
+func NewApply(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Apply a manifest of Kafka topics, ACLs, and role bindings.",
+		Long:  "Create and update the Kafka topics, ACLs, and role bindings listed in a manifest so that the Kafka cluster matches it. The number of partitions and replication factor of existing topics are never changed, and topics are never deleted. Role bindings are only supported on Confluent Platform.",
+		Args:  cobra.NoArgs,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Apply "resources.yaml".`,
+				Code: "confluent apply --file resources.yaml" + urlExample(cfg),
+			},
+			examples.Example{
+				Text: `Show the changes which would be made by applying "resources.yaml", and delete the ACLs and role bindings of its principals which it does not list.`,
+				Code: "confluent apply --file resources.yaml --prune --dry-run",
+			},
+		),
+	}
+
+	c := newCommand(cfg, cmd, prerunner)
+	cmd.RunE = c.apply
+	pcmd.AddDryRunFlag(cmd)
+
+	return cmd
+}
+
+func (c *command) apply(cmd *cobra.Command, _ []string) error {
+	plan, cluster, err := c.plan(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := c.printPlan(cmd, plan); err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+	if dryRun || len(plan) == 0 {
+		return nil
+	}
+
+	for i, change := range plan {
+		if err := c.applyChange(cluster, change); err != nil {
+			return fmt.Errorf("applied %d of %d change(s) before failing to %s: %w", i, len(plan), change, err)
+		}
+	}
+
+	if output.GetFormat(cmd) == output.Human {
+		output.Printf(c.Config.EnableColor, "Applied %d change(s).\n", len(plan))
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package manifest
+
+import (
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+)
+
+func NewDiff(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes needed to apply a manifest.",
+		Long:  "Compare a manifest of Kafka topics, ACLs, and role bindings with the current state of the Kafka cluster, and show the changes which `confluent apply` would make. Role bindings are only supported on Confluent Platform.",
+		Args:  cobra.NoArgs,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Show the changes needed to apply "resources.yaml".`,
+				Code: "confluent diff --file resources.yaml" + urlExample(cfg),
+			},
+		),
+	}
+
+	c := newCommand(cfg, cmd, prerunner)
+	cmd.RunE = c.diff
+
+	return cmd
+}
+
+func (c *command) diff(cmd *cobra.Command, _ []string) error {
+	plan, _, err := c.plan(cmd)
+	if err != nil {
+		return err
+	}
+
+	return c.printPlan(cmd, plan)
+}
@@ -0,0 +1,165 @@
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/confluentinc/mds-sdk-go-public/mdsv1"
+)
+
+var (
+	aclPermissions  = []string{"ALLOW", "DENY"}
+	aclPatternTypes = []string{"LITERAL", "PREFIXED"}
+)
+
+// Manifest is the desired state of a Kafka cluster, as read by `confluent apply` and `confluent diff`.
+type Manifest struct {
+	Topics       []Topic       `yaml:"topics"`
+	Acls         []Acl         `yaml:"acls"`
+	RoleBindings []RoleBinding `yaml:"role_bindings"`
+}
+
+// Topic is a topic which must exist. Partitions and replication factor are only used to create the topic, and
+// only the listed configs are compared with the topic's current configs.
+type Topic struct {
+	Name              string            `yaml:"name"`
+	Partitions        int32             `yaml:"partitions"`
+	ReplicationFactor int32             `yaml:"replication_factor"`
+	Configs           map[string]string `yaml:"configs"`
+}
+
+type Acl struct {
+	Principal    string `yaml:"principal"`
+	Permission   string `yaml:"permission"`
+	Operation    string `yaml:"operation"`
+	ResourceType string `yaml:"resource_type"`
+	ResourceName string `yaml:"resource_name"`
+	PatternType  string `yaml:"pattern_type"`
+	Host         string `yaml:"host"`
+}
+
+// RoleBinding is a role binding in the scope of the Kafka cluster. Resource is formatted as "<type>:<name>", and
+// is empty for cluster-scoped roles.
+type RoleBinding struct {
+	Principal string `yaml:"principal"`
+	Role      string `yaml:"role"`
+	Resource  string `yaml:"resource"`
+	Prefix    bool   `yaml:"prefix"`
+}
+
+func ReadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := new(Manifest)
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(manifest); err != nil {
+		return nil, fmt.Errorf(`failed to parse manifest "%s": %w`, path, err)
+	}
+
+	if err := manifest.validate(); err != nil {
+		return nil, fmt.Errorf(`invalid manifest "%s": %w`, path, err)
+	}
+
+	return manifest, nil
+}
+
+// validate checks the manifest and fills in default values.
+func (m *Manifest) validate() error {
+	topics := make(map[string]bool)
+	for _, topic := range m.Topics {
+		if topic.Name == "" {
+			return fmt.Errorf("every topic must have a name")
+		}
+		if topics[topic.Name] {
+			return fmt.Errorf(`topic "%s" is listed more than once`, topic.Name)
+		}
+		topics[topic.Name] = true
+
+		if topic.Partitions < 0 || topic.ReplicationFactor < 0 {
+			return fmt.Errorf(`topic "%s" must have a positive number of partitions and replication factor`, topic.Name)
+		}
+	}
+
+	for i := range m.Acls {
+		acl := &m.Acls[i]
+
+		acl.Permission = strings.ToUpper(acl.Permission)
+		acl.Operation = strings.ToUpper(acl.Operation)
+		acl.ResourceType = strings.ToUpper(acl.ResourceType)
+		acl.PatternType = strings.ToUpper(acl.PatternType)
+		if acl.PatternType == "" {
+			acl.PatternType = "LITERAL"
+		}
+		if acl.Host == "" {
+			acl.Host = "*"
+		}
+
+		if acl.Principal == "" || acl.Operation == "" || acl.ResourceType == "" {
+			return fmt.Errorf("every ACL must have a principal, operation, and resource type")
+		}
+		if acl.ResourceName == "" && acl.ResourceType != "CLUSTER" {
+			return fmt.Errorf("ACLs for %s resources must have a resource name", strings.ToLower(acl.ResourceType))
+		}
+		if acl.ResourceType == "CLUSTER" {
+			acl.ResourceName = "kafka-cluster"
+		}
+		if !slices.Contains(aclPermissions, acl.Permission) {
+			return fmt.Errorf(`ACL permission must be "ALLOW" or "DENY"`)
+		}
+		if !slices.Contains(aclPatternTypes, acl.PatternType) {
+			return fmt.Errorf(`ACL pattern type must be "LITERAL" or "PREFIXED"`)
+		}
+	}
+
+	for _, roleBinding := range m.RoleBindings {
+		if roleBinding.Principal == "" || roleBinding.Role == "" {
+			return fmt.Errorf("every role binding must have a principal and role")
+		}
+		if !strings.HasPrefix(roleBinding.Principal, "User:") && !strings.HasPrefix(roleBinding.Principal, "Group:") {
+			return fmt.Errorf(`role binding principal "%s" must start with "User:" or "Group:"`, roleBinding.Principal)
+		}
+		if roleBinding.Resource != "" && !strings.Contains(roleBinding.Resource, ":") {
+			return fmt.Errorf(`role binding resource "%s" must be formatted as "<type>:<name>"`, roleBinding.Resource)
+		}
+	}
+
+	return nil
+}
+
+func (a Acl) String() string {
+	return fmt.Sprintf("%s %s %s on %s:%s (%s) from host %s", a.Principal, a.Permission, a.Operation, a.ResourceType, a.ResourceName, a.PatternType, a.Host)
+}
+
+func (r RoleBinding) String() string {
+	if r.Resource == "" {
+		return fmt.Sprintf("%s %s", r.Principal, r.Role)
+	}
+	return fmt.Sprintf("%s %s on %s (%s)", r.Principal, r.Role, r.Resource, r.resourcePattern().PatternType)
+}
+
+// equal compares role bindings, ignoring the case of the resource type since MDS returns types such as "Topic" while
+// manifests may list them as "topic" or "TOPIC".
+func (r RoleBinding) equal(other RoleBinding) bool {
+	resourceType, name, _ := strings.Cut(r.Resource, ":")
+	otherResourceType, otherName, _ := strings.Cut(other.Resource, ":")
+	return r.Principal == other.Principal && r.Role == other.Role && r.Prefix == other.Prefix &&
+		strings.EqualFold(resourceType, otherResourceType) && name == otherName
+}
+
+func (r RoleBinding) resourcePattern() mdsv1.ResourcePattern {
+	resourceType, name, _ := strings.Cut(r.Resource, ":")
+	pattern := mdsv1.ResourcePattern{ResourceType: resourceType, Name: name, PatternType: "LITERAL"}
+	if r.Prefix {
+		pattern.PatternType = "PREFIXED"
+	}
+	return pattern
+}
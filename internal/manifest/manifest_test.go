@@ -0,0 +1,66 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadManifest(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resources.yaml")
+	data := `topics:
+  - name: orders
+    partitions: 6
+    configs:
+      retention.ms: "86400000"
+acls:
+  - principal: User:alice
+    permission: allow
+    operation: read
+    resource_type: topic
+    resource_name: orders
+  - principal: User:alice
+    permission: ALLOW
+    operation: DESCRIBE
+    resource_type: CLUSTER
+role_bindings:
+  - principal: User:bob
+    role: ResourceOwner
+    resource: Topic:orders
+    prefix: true
+`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+
+	manifest, err := ReadManifest(path)
+	require.NoError(t, err)
+
+	require.Equal(t, []Topic{{Name: "orders", Partitions: 6, Configs: map[string]string{"retention.ms": "86400000"}}}, manifest.Topics)
+	require.Equal(t, []Acl{
+		{Principal: "User:alice", Permission: "ALLOW", Operation: "READ", ResourceType: "TOPIC", ResourceName: "orders", PatternType: "LITERAL", Host: "*"},
+		{Principal: "User:alice", Permission: "ALLOW", Operation: "DESCRIBE", ResourceType: "CLUSTER", ResourceName: "kafka-cluster", PatternType: "LITERAL", Host: "*"},
+	}, manifest.Acls)
+	require.Equal(t, []RoleBinding{{Principal: "User:bob", Role: "ResourceOwner", Resource: "Topic:orders", Prefix: true}}, manifest.RoleBindings)
+	require.Equal(t, "PREFIXED", manifest.RoleBindings[0].resourcePattern().PatternType)
+}
+
+func TestReadManifest_Fail(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, data := range map[string]string{
+		"unknown-field.yaml":     "topics:\n  - name: orders\n    partition: 6\n",
+		"duplicate-topic.yaml":   "topics:\n  - name: orders\n  - name: orders\n",
+		"negative.yaml":          "topics:\n  - name: orders\n    partitions: -1\n",
+		"acl-permission.yaml":    "acls:\n  - principal: User:alice\n    permission: maybe\n    operation: READ\n    resource_type: TOPIC\n    resource_name: orders\n",
+		"acl-resource.yaml":      "acls:\n  - principal: User:alice\n    permission: ALLOW\n    operation: READ\n    resource_type: TOPIC\n",
+		"binding-principal.yaml": "role_bindings:\n  - principal: alice\n    role: DeveloperRead\n",
+		"binding-resource.yaml":  "role_bindings:\n  - principal: User:alice\n    role: DeveloperRead\n    resource: orders\n",
+	} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+
+		_, err := ReadManifest(path)
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,170 @@
+package manifest
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/resource"
+)
+
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+// clusterState is the current state of the resources managed by a manifest.
+type clusterState struct {
+	Topics       map[string]topicState
+	Acls         []Acl
+	RoleBindings []RoleBinding
+}
+
+type topicState struct {
+	Partitions        int32
+	ReplicationFactor int32
+	Configs           map[string]string
+}
+
+// change is a single step of a plan. Only one of Topic, Acl, and RoleBinding is set, depending on the resource.
+type change struct {
+	Action      string
+	Resource    string
+	Topic       *Topic
+	Acl         *Acl
+	RoleBinding *RoleBinding
+}
+
+type changeOut struct {
+	Action   string `human:"Action" serialized:"action"`
+	Resource string `human:"Resource" serialized:"resource"`
+	Name     string `human:"Name" serialized:"name"`
+	Details  string `human:"Details,omitempty" serialized:"details,omitempty"`
+}
+
+// computePlan returns the changes needed to bring the cluster from its current state to the state described by the
+// manifest. If prune is set, ACLs and role bindings of the principals in the manifest which are not listed in the
+// manifest are deleted. Topics are never deleted.
+func computePlan(manifest *Manifest, state *clusterState, prune bool) ([]*change, error) {
+	var plan []*change
+
+	for i := range manifest.Topics {
+		topic := &manifest.Topics[i]
+
+		current, ok := state.Topics[topic.Name]
+		if !ok {
+			plan = append(plan, &change{Action: actionCreate, Resource: resource.Topic, Topic: topic})
+			continue
+		}
+
+		if topic.Partitions != 0 && topic.Partitions != current.Partitions {
+			return nil, fmt.Errorf(`topic "%s" has %d partitions but the manifest requires %d: the number of partitions cannot be changed by a manifest`, topic.Name, current.Partitions, topic.Partitions)
+		}
+		if topic.ReplicationFactor != 0 && topic.ReplicationFactor != current.ReplicationFactor {
+			return nil, fmt.Errorf(`topic "%s" has a replication factor of %d but the manifest requires %d: the replication factor cannot be changed by a manifest`, topic.Name, current.ReplicationFactor, topic.ReplicationFactor)
+		}
+
+		configs := make(map[string]string)
+		for name, value := range topic.Configs {
+			if currentValue, ok := current.Configs[name]; !ok || currentValue != value {
+				configs[name] = value
+			}
+		}
+		if len(configs) > 0 {
+			plan = append(plan, &change{Action: actionUpdate, Resource: resource.Topic, Topic: &Topic{Name: topic.Name, Configs: configs}})
+		}
+	}
+
+	for i := range manifest.Acls {
+		if !slices.Contains(state.Acls, manifest.Acls[i]) {
+			plan = append(plan, &change{Action: actionCreate, Resource: resource.ACL, Acl: &manifest.Acls[i]})
+		}
+	}
+
+	for i := range manifest.RoleBindings {
+		if !slices.ContainsFunc(state.RoleBindings, manifest.RoleBindings[i].equal) {
+			plan = append(plan, &change{Action: actionCreate, Resource: resource.RoleBinding, RoleBinding: &manifest.RoleBindings[i]})
+		}
+	}
+
+	if prune {
+		principals := manifest.aclPrincipals()
+		for i := range state.Acls {
+			if principals[state.Acls[i].Principal] && !slices.Contains(manifest.Acls, state.Acls[i]) {
+				plan = append(plan, &change{Action: actionDelete, Resource: resource.ACL, Acl: &state.Acls[i]})
+			}
+		}
+
+		principals = manifest.roleBindingPrincipals()
+		for i := range state.RoleBindings {
+			if principals[state.RoleBindings[i].Principal] && !slices.ContainsFunc(manifest.RoleBindings, state.RoleBindings[i].equal) {
+				plan = append(plan, &change{Action: actionDelete, Resource: resource.RoleBinding, RoleBinding: &state.RoleBindings[i]})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func (m *Manifest) aclPrincipals() map[string]bool {
+	principals := make(map[string]bool)
+	for _, acl := range m.Acls {
+		principals[acl.Principal] = true
+	}
+	return principals
+}
+
+func (m *Manifest) roleBindingPrincipals() map[string]bool {
+	principals := make(map[string]bool)
+	for _, roleBinding := range m.RoleBindings {
+		principals[roleBinding.Principal] = true
+	}
+	return principals
+}
+
+func (c *change) toOutput() *changeOut {
+	out := &changeOut{
+		Action:   c.Action,
+		Resource: c.Resource,
+	}
+
+	switch {
+	case c.Topic != nil:
+		out.Name = c.Topic.Name
+		out.Details = formatTopicDetails(c.Topic, c.Action == actionCreate)
+	case c.Acl != nil:
+		out.Name = c.Acl.String()
+	case c.RoleBinding != nil:
+		out.Name = c.RoleBinding.String()
+	}
+
+	return out
+}
+
+func formatTopicDetails(topic *Topic, create bool) string {
+	var details []string
+	if create && topic.Partitions != 0 {
+		details = append(details, fmt.Sprintf("partitions=%d", topic.Partitions))
+	}
+	if create && topic.ReplicationFactor != 0 {
+		details = append(details, fmt.Sprintf("replication_factor=%d", topic.ReplicationFactor))
+	}
+
+	names := make([]string, 0, len(topic.Configs))
+	for name := range topic.Configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		details = append(details, fmt.Sprintf("%s=%s", name, topic.Configs[name]))
+	}
+
+	return strings.Join(details, ", ")
+}
+
+func (c *change) String() string {
+	out := c.toOutput()
+	return fmt.Sprintf("%s %s %s", out.Action, out.Resource, out.Name)
+}
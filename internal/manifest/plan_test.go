@@ -0,0 +1,82 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/resource"
+)
+
+func TestComputePlan(t *testing.T) {
+	readOrders := Acl{Principal: "User:alice", Permission: "ALLOW", Operation: "READ", ResourceType: "TOPIC", ResourceName: "orders", PatternType: "LITERAL", Host: "*"}
+	writeOrders := Acl{Principal: "User:alice", Permission: "ALLOW", Operation: "WRITE", ResourceType: "TOPIC", ResourceName: "orders", PatternType: "LITERAL", Host: "*"}
+	readPayments := Acl{Principal: "User:bob", Permission: "ALLOW", Operation: "READ", ResourceType: "TOPIC", ResourceName: "payments", PatternType: "LITERAL", Host: "*"}
+	owner := RoleBinding{Principal: "User:alice", Role: "ResourceOwner", Resource: "Topic:orders"}
+	operator := RoleBinding{Principal: "User:alice", Role: "Operator"}
+
+	manifest := &Manifest{
+		Topics: []Topic{
+			{Name: "orders", Partitions: 6, Configs: map[string]string{"retention.ms": "1000", "cleanup.policy": "delete"}},
+			{Name: "payments", Partitions: 3, ReplicationFactor: 3},
+		},
+		Acls:         []Acl{readOrders},
+		RoleBindings: []RoleBinding{owner},
+	}
+	state := &clusterState{
+		Topics: map[string]topicState{
+			"orders": {Partitions: 6, ReplicationFactor: 3, Configs: map[string]string{"retention.ms": "500", "cleanup.policy": "delete"}},
+		},
+		Acls:         []Acl{writeOrders, readPayments},
+		RoleBindings: []RoleBinding{operator},
+	}
+
+	plan, err := computePlan(manifest, state, false)
+	require.NoError(t, err)
+	require.Equal(t, []*change{
+		{Action: actionUpdate, Resource: resource.Topic, Topic: &Topic{Name: "orders", Configs: map[string]string{"retention.ms": "1000"}}},
+		{Action: actionCreate, Resource: resource.Topic, Topic: &manifest.Topics[1]},
+		{Action: actionCreate, Resource: resource.ACL, Acl: &readOrders},
+		{Action: actionCreate, Resource: resource.RoleBinding, RoleBinding: &owner},
+	}, plan)
+
+	plan, err = computePlan(manifest, state, true)
+	require.NoError(t, err)
+	require.Len(t, plan, 6)
+	require.Equal(t, &change{Action: actionDelete, Resource: resource.ACL, Acl: &writeOrders}, plan[4])
+	require.Equal(t, &change{Action: actionDelete, Resource: resource.RoleBinding, RoleBinding: &operator}, plan[5])
+}
+
+func TestComputePlan_RoleBindingResourceType(t *testing.T) {
+	manifest := &Manifest{RoleBindings: []RoleBinding{{Principal: "User:alice", Role: "ResourceOwner", Resource: "TOPIC:orders"}}}
+	state := &clusterState{RoleBindings: []RoleBinding{{Principal: "User:alice", Role: "ResourceOwner", Resource: "Topic:orders"}}}
+
+	plan, err := computePlan(manifest, state, true)
+	require.NoError(t, err)
+	require.Empty(t, plan)
+}
+
+func TestComputePlan_NoChanges(t *testing.T) {
+	manifest := &Manifest{Topics: []Topic{{Name: "orders", Configs: map[string]string{"retention.ms": "1000"}}}}
+	state := &clusterState{Topics: map[string]topicState{"orders": {Partitions: 6, ReplicationFactor: 3, Configs: map[string]string{"retention.ms": "1000"}}}}
+
+	plan, err := computePlan(manifest, state, true)
+	require.NoError(t, err)
+	require.Empty(t, plan)
+}
+
+func TestComputePlan_Partitions(t *testing.T) {
+	manifest := &Manifest{Topics: []Topic{{Name: "orders", Partitions: 12}}}
+	state := &clusterState{Topics: map[string]topicState{"orders": {Partitions: 6, ReplicationFactor: 3}}}
+
+	_, err := computePlan(manifest, state, false)
+	require.EqualError(t, err, `topic "orders" has 6 partitions but the manifest requires 12: the number of partitions cannot be changed by a manifest`)
+}
+
+func TestChange_ToOutput(t *testing.T) {
+	topic := &change{Action: actionCreate, Resource: resource.Topic, Topic: &Topic{Name: "orders", Partitions: 6, Configs: map[string]string{"retention.ms": "1000", "cleanup.policy": "compact"}}}
+	require.Equal(t, &changeOut{Action: "create", Resource: "topic", Name: "orders", Details: "partitions=6, cleanup.policy=compact, retention.ms=1000"}, topic.toOutput())
+
+	roleBinding := &change{Action: actionDelete, Resource: resource.RoleBinding, RoleBinding: &RoleBinding{Principal: "User:alice", Role: "ResourceOwner", Resource: "Topic:orders", Prefix: true}}
+	require.Equal(t, "delete role binding User:alice ResourceOwner on Topic:orders (PREFIXED)", roleBinding.String())
+}
@@ -0,0 +1,106 @@
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/confluentinc/mds-sdk-go-public/mdsv1"
+)
+
+// getClusterState reads the topics, ACLs, and role bindings which the manifest may change.
+func (c *command) getClusterState(cluster kafkaCluster, manifest *Manifest) (*clusterState, error) {
+	state := &clusterState{Topics: make(map[string]topicState)}
+
+	if len(manifest.Topics) > 0 {
+		topics, err := cluster.listTopics()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, topic := range manifest.Topics {
+			current, ok := topics[topic.Name]
+			if !ok {
+				continue
+			}
+
+			current.Configs, err = cluster.listTopicConfigs(topic.Name)
+			if err != nil {
+				return nil, err
+			}
+			state.Topics[topic.Name] = current
+		}
+	}
+
+	if len(manifest.Acls) > 0 {
+		acls, err := cluster.listAcls()
+		if err != nil {
+			return nil, err
+		}
+		state.Acls = acls
+	}
+
+	scope := kafkaClusterScope(cluster.id())
+	for principal := range manifest.roleBindingPrincipals() {
+		rolesResourcePatterns, _, err := c.MDSClient.RBACRoleBindingSummariesApi.LookupResourcesForPrincipal(c.mdsContext(), principal, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		// Role bindings inherited from the groups of a user are listed under the group, and are left alone.
+		for role, resourcePatterns := range rolesResourcePatterns[principal] {
+			if len(resourcePatterns) == 0 {
+				state.RoleBindings = append(state.RoleBindings, RoleBinding{Principal: principal, Role: role})
+			}
+			for _, resourcePattern := range resourcePatterns {
+				state.RoleBindings = append(state.RoleBindings, RoleBinding{
+					Principal: principal,
+					Role:      role,
+					Resource:  fmt.Sprintf("%s:%s", resourcePattern.ResourceType, resourcePattern.Name),
+					Prefix:    resourcePattern.PatternType == "PREFIXED",
+				})
+			}
+		}
+	}
+
+	return state, nil
+}
+
+// applyChange makes a single change of a plan.
+func (c *command) applyChange(cluster kafkaCluster, change *change) error {
+	switch {
+	case change.Topic != nil && change.Action == actionCreate:
+		return cluster.createTopic(change.Topic)
+	case change.Topic != nil:
+		return cluster.updateTopicConfigs(change.Topic)
+	case change.Acl != nil && change.Action == actionCreate:
+		return cluster.createAcl(change.Acl)
+	case change.Acl != nil:
+		return cluster.deleteAcl(change.Acl)
+	case change.RoleBinding != nil:
+		return c.applyRoleBindingChange(cluster.id(), change)
+	}
+	return nil
+}
+
+func (c *command) applyRoleBindingChange(clusterId string, change *change) error {
+	roleBinding := change.RoleBinding
+	scope := kafkaClusterScope(clusterId)
+
+	var err error
+	switch {
+	case roleBinding.Resource == "" && change.Action == actionCreate:
+		_, err = c.MDSClient.RBACRoleBindingCRUDApi.AddRoleForPrincipal(c.mdsContext(), roleBinding.Principal, roleBinding.Role, scope)
+	case roleBinding.Resource == "":
+		_, err = c.MDSClient.RBACRoleBindingCRUDApi.DeleteRoleForPrincipal(c.mdsContext(), roleBinding.Principal, roleBinding.Role, scope)
+	case change.Action == actionCreate:
+		request := mdsv1.ResourcesRequest{Scope: scope, ResourcePatterns: []mdsv1.ResourcePattern{roleBinding.resourcePattern()}}
+		_, err = c.MDSClient.RBACRoleBindingCRUDApi.AddRoleResourcesForPrincipal(c.mdsContext(), roleBinding.Principal, roleBinding.Role, request)
+	default:
+		request := mdsv1.ResourcesRequest{Scope: scope, ResourcePatterns: []mdsv1.ResourcePattern{roleBinding.resourcePattern()}}
+		_, err = c.MDSClient.RBACRoleBindingCRUDApi.RemoveRoleResourcesForPrincipal(c.mdsContext(), roleBinding.Principal, roleBinding.Role, request)
+	}
+	return err
+}
+
+func kafkaClusterScope(clusterId string) mdsv1.MdsScope {
+	return mdsv1.MdsScope{Clusters: mdsv1.MdsScopeClusters{KafkaCluster: clusterId}}
+}
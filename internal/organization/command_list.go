@@ -27,6 +27,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, organization := range organizations {
 		list.Add(&out{
 			IsCurrent:  organization.GetId() == c.Context.GetCurrentOrganization(),
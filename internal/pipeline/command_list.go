@@ -29,6 +29,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(serializedOut))
 	for _, pipeline := range pipelines {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&humanOut{
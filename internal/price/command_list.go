@@ -256,6 +256,7 @@ func filterTable(table map[string]*ccloudv1.UnitPrices, filters []string, metric
 
 func printTable(cmd *cobra.Command, rows []row) error {
 	list := output.NewList(cmd)
+	list.Columns(new(serializedOut))
 	for _, row := range rows {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&humanOut{
@@ -34,7 +34,7 @@ func New(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 	cmd.AddCommand(c.newConfigCommand(cfg))
 	cmd.AddCommand(c.newExporterCommand(cfg))
 	cmd.AddCommand(c.newRegionCommand())
-	cmd.AddCommand(c.newSchemaCommand(cfg))
+	cmd.AddCommand(c.newSchemaCommand(cfg, prerunner))
 	cmd.AddCommand(c.newSubjectCommand(cfg))
 
 	return cmd
@@ -54,6 +54,7 @@ func (c *command) exporterList(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(listOut))
 	for _, exporter := range exporters {
 		list.Add(&listOut{Exporter: exporter})
 	}
@@ -55,6 +55,7 @@ func (c *command) list(cmd *cobra.Command, _ []string) error {
 
 func printRegionList(cmd *cobra.Command, regionList []srcmv2.SrcmV2Region) error {
 	outputList := output.NewList(cmd)
+	outputList.Columns(new(regionSerializedOut))
 
 	for _, region := range regionList {
 		regionSpec := region.GetSpec()
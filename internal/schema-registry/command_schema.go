@@ -11,7 +11,7 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/errors"
 )
 
-func (c *command) newSchemaCommand(cfg *config.Config) *cobra.Command {
+func (c *command) newSchemaCommand(cfg *config.Config, prerunner pcmd.PreRunner) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "schema",
 		Short:       "Manage Schema Registry schemas.",
@@ -21,6 +21,9 @@ func (c *command) newSchemaCommand(cfg *config.Config) *cobra.Command {
 	cmd.AddCommand(c.newSchemaCreateCommand(cfg))
 	cmd.AddCommand(c.newSchemaDeleteCommand(cfg))
 	cmd.AddCommand(c.newSchemaDescribeCommand(cfg))
+	cmd.AddCommand(c.newSchemaDiffCommand(cfg))
+	cmd.AddCommand(c.newSchemaDownloadCommand(cfg))
+	cmd.AddCommand(newSchemaLintCommand(prerunner))
 	cmd.AddCommand(c.newSchemaListCommand(cfg))
 
 	return cmd
@@ -135,6 +135,7 @@ func (c *command) schemaDelete(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(versionOut))
 	for _, version := range versions {
 		list.Add(&versionOut{Version: version})
 	}
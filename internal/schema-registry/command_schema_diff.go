@@ -0,0 +1,197 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+)
+
+type schemaDiffOut struct {
+	Path   string `human:"Path" serialized:"path"`
+	Change string `human:"Change" serialized:"change"`
+	Old    string `human:"Old" serialized:"old"`
+	New    string `human:"New" serialized:"new"`
+}
+
+func (c *command) newSchemaDiffCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compare two versions of a schema.",
+		Long:  "Compare two versions of a schema, or a version of a schema and a schema file, and list the fields, messages, and types which were added, removed, or changed. A schema file is assumed to have the type of the registered schema, unless `--type` is passed.",
+		Args:  cobra.NoArgs,
+		RunE:  c.schemaDiff,
+	}
+
+	example1 := examples.Example{
+		Text: `Compare version 3 of subject "payments" with the latest version.`,
+		Code: "confluent schema-registry schema diff --subject payments --from 3 --to latest",
+	}
+	example2 := examples.Example{
+		Text: `Compare the latest version of subject "payments" with the schema file "payments.avsc".`,
+		Code: "confluent schema-registry schema diff --subject payments --from latest --schema payments.avsc",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example1, example2)
+
+	cmd.Flags().String("subject", "", subjectUsage)
+	cmd.Flags().String("from", "", `The version of the schema to compare from. Can be a specific version or "latest".`)
+	cmd.Flags().String("to", "latest", `The version of the schema to compare to. Can be a specific version or "latest".`)
+	cmd.Flags().String("schema", "", "The path to a schema file to compare to, instead of a version of the schema.")
+	pcmd.AddSchemaTypeFlag(cmd)
+	cmd.Flags().String("references", "", "The path to the references file of the schema file.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationFlag(cmd)
+		addSchemaRegistryEndpointFlag(cmd)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("schema", "avsc", "json", "proto"))
+	cobra.CheckErr(cmd.MarkFlagFilename("references", "json"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("subject"))
+	cobra.CheckErr(cmd.MarkFlagRequired("from"))
+
+	cmd.MarkFlagsMutuallyExclusive("to", "schema")
+	cmd.MarkFlagsMutuallyExclusive("to", "type")
+
+	return cmd
+}
+
+func (c *command) schemaDiff(cmd *cobra.Command, _ []string) error {
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	from, err := cmd.Flags().GetString("from")
+	if err != nil {
+		return err
+	}
+
+	to, err := cmd.Flags().GetString("to")
+	if err != nil {
+		return err
+	}
+
+	schemaPath, err := cmd.Flags().GetString("schema")
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	dir, err := CreateTempDir()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	// Each version is written to its own directory, since both versions may have references with the same name.
+	oldDir := filepath.Join(dir, "old")
+	newDir := filepath.Join(dir, "new")
+	for _, versionDir := range []string{oldDir, newDir} {
+		if err := os.MkdirAll(versionDir, 0755); err != nil {
+			return err
+		}
+	}
+
+	oldSchemaPath, oldReferencePathMap, schemaType, err := downloadSchemaVersion(subject, from, oldDir, client)
+	if err != nil {
+		return err
+	}
+
+	var newSchemaPath string
+	var newReferencePathMap map[string]string
+	if schemaPath != "" {
+		newSchemaType, err := cmd.Flags().GetString("type")
+		if err != nil {
+			return err
+		}
+		if newSchemaType != "" && strings.ToLower(newSchemaType) != schemaType {
+			return fmt.Errorf(`cannot compare a %s schema with a %s schema`, schemaType, strings.ToLower(newSchemaType))
+		}
+
+		refs, err := ReadSchemaReferences(cmd, false)
+		if err != nil {
+			return err
+		}
+		newReferencePathMap, err = StoreSchemaReferences(newDir, refs, client)
+		if err != nil {
+			return err
+		}
+		newSchemaPath = schemaPath
+	} else {
+		var newSchemaType string
+		newSchemaPath, newReferencePathMap, newSchemaType, err = downloadSchemaVersion(subject, to, newDir, client)
+		if err != nil {
+			return err
+		}
+		if newSchemaType != schemaType {
+			return fmt.Errorf(`cannot compare a %s schema with a %s schema`, schemaType, newSchemaType)
+		}
+	}
+
+	changes, err := serdes.DiffSchemas(schemaType, oldSchemaPath, oldReferencePathMap, newSchemaPath, newReferencePathMap)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(schemaDiffOut))
+	list.Sort(false)
+	for _, change := range changes {
+		list.Add(&schemaDiffOut{
+			Path:   change.Path,
+			Change: change.Change,
+			Old:    change.Old,
+			New:    change.New,
+		})
+	}
+	return list.Print()
+}
+
+// downloadSchemaVersion writes a version of a schema and its references to a directory, and returns the path of the
+// schema along with its references and its type as "avro", "json", or "protobuf".
+func downloadSchemaVersion(subject, version, dir string, client *schemaregistry.Client) (string, map[string]string, string, error) {
+	schema, err := client.GetSchemaByVersion(subject, version, nil)
+	if err != nil {
+		return "", nil, "", catchSchemaNotFoundError(err, subject, version)
+	}
+
+	schemaString := srsdk.SchemaString{SchemaType: schema.SchemaType, Schema: schema.Schema, References: schema.References}
+	path, referencePathMap, err := SetSchemaPathRef(schemaString, dir, subject, schema.Id, client)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	// The backend considers "AVRO" to be the default schema type.
+	schemaType := strings.ToLower(schema.SchemaType)
+	if schemaType == "" {
+		schemaType = "avro"
+	}
+
+	return path, referencePathMap, schemaType, nil
+}
@@ -0,0 +1,177 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+)
+
+var schemaFileExtensions = map[string]string{
+	"AVRO":     ".avsc",
+	"JSON":     ".json",
+	"PROTOBUF": ".proto",
+}
+
+type schemaDownloadOut struct {
+	Subject string `human:"Subject" serialized:"subject"`
+	Version int32  `human:"Version" serialized:"version"`
+	File    string `human:"File" serialized:"file"`
+}
+
+func (c *command) newSchemaDownloadCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Download a schema and its references to files.",
+		Long:  "Download a schema and all of the schemas it references to files, which can be passed to code generators such as protoc or avro-tools. Each reference is written to the path it is imported by, so Protobuf imports resolve with the download directory as an import path. Avro references are named after the types they define.",
+		Args:  cobra.NoArgs,
+		RunE:  c.schemaDownload,
+	}
+
+	example := examples.Example{
+		Text: `Download the latest schema of subject "payments" and its references to the directory "schemas".`,
+		Code: "confluent schema-registry schema download --subject payments --dir schemas",
+	}
+	if cfg.IsOnPremLogin() {
+		example.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example)
+
+	cmd.Flags().String("subject", "", subjectUsage)
+	cmd.Flags().String("version", "latest", `Version of the schema. Can be a specific version or "latest".`)
+	cmd.Flags().String("dir", "", "The directory to download the schema files to.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationFlag(cmd)
+		addSchemaRegistryEndpointFlag(cmd)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagDirname("dir"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("subject"))
+	cobra.CheckErr(cmd.MarkFlagRequired("dir"))
+
+	return cmd
+}
+
+func (c *command) schemaDownload(cmd *cobra.Command, _ []string) error {
+	subject, err := cmd.Flags().GetString("subject")
+	if err != nil {
+		return err
+	}
+
+	version, err := cmd.Flags().GetString("version")
+	if err != nil {
+		return err
+	}
+
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	schema, err := client.GetSchemaByVersion(subject, version, nil)
+	if err != nil {
+		return catchSchemaNotFoundError(err, subject, version)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	file := url.QueryEscape(schema.Subject) + getSchemaFileExtension(schema.SchemaType)
+	if err := os.WriteFile(filepath.Join(dir, file), []byte(schema.Schema), 0644); err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(schemaDownloadOut))
+	list.Sort(false)
+	list.Add(&schemaDownloadOut{
+		Subject: schema.Subject,
+		Version: schema.Version,
+		File:    file,
+	})
+
+	visited := make(map[string]bool)
+	if err := downloadSchemaReferences(dir, schema.SchemaType, schema.References, client, visited, list); err != nil {
+		return err
+	}
+
+	return list.Print()
+}
+
+// downloadSchemaReferences writes each schema in a reference graph once, by the name it is referenced with, replacing
+// existing files. Avro references are named after a type, so the ".avsc" extension is added to their file names.
+func downloadSchemaReferences(dir, schemaType string, refs []srsdk.SchemaReference, client *schemaregistry.Client, visited map[string]bool, list *output.Table) error {
+	for _, ref := range refs {
+		key := fmt.Sprintf("%s#%d", ref.Subject, ref.Version)
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		if extension := getSchemaFileExtension(schemaType); extension == ".avsc" && filepath.Ext(ref.Name) != extension {
+			ref.Name += extension
+		}
+		// Reference names come from Schema Registry, and must not write outside of the directory.
+		if !filepath.IsLocal(ref.Name) {
+			return fmt.Errorf(`invalid name "%s" for the reference to version %d of subject "%s": must be a relative path without ".."`, ref.Name, ref.Version, ref.Subject)
+		}
+
+		schema, err := client.GetSchemaByVersion(ref.Subject, strconv.Itoa(int(ref.Version)), &srsdk.GetSchemaByVersionOpts{Deleted: optional.NewBool(true)})
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(dir, ref.Name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, []byte(schema.Schema), 0644); err != nil {
+			return err
+		}
+
+		list.Add(&schemaDownloadOut{
+			Subject: ref.Subject,
+			Version: ref.Version,
+			File:    ref.Name,
+		})
+
+		if err := downloadSchemaReferences(dir, schema.SchemaType, schema.References, client, visited, list); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getSchemaFileExtension returns the file extension of a schema type. The backend considers "AVRO" to be the default
+// schema type.
+func getSchemaFileExtension(schemaType string) string {
+	if extension, ok := schemaFileExtensions[strings.ToUpper(schemaType)]; ok {
+		return extension
+	}
+	return ".avsc"
+}
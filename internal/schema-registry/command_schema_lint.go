@@ -0,0 +1,128 @@
+package schemaregistry
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/serdes"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+var schemaTypesByExtension = map[string]string{
+	".avsc":  "avro",
+	".json":  "json",
+	".proto": "protobuf",
+}
+
+type lintCommand struct {
+	*pcmd.CLICommand
+}
+
+type lintOut struct {
+	OldSchema string `human:"Old Schema" serialized:"old_schema"`
+	Direction string `human:"Direction" serialized:"direction"`
+	Path      string `human:"Path" serialized:"path"`
+	Reason    string `human:"Reason" serialized:"reason"`
+}
+
+func newSchemaLintCommand(prerunner pcmd.PreRunner) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Check schema compatibility locally.",
+		Long:  "Check that a new schema is compatible with its old versions without Schema Registry, and list each incompatible change. The command fails if an incompatible change is found.",
+		Args:  cobra.NoArgs,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `Check that "employee-v3.avsc" is backward compatible with all previous versions.`,
+				Code: "confluent schema-registry schema lint --old employee-v1.avsc --old employee-v2.avsc --new employee-v3.avsc --compatibility backward_transitive",
+			},
+		),
+	}
+
+	c := &lintCommand{pcmd.NewAnonymousCLICommand(cmd, prerunner)}
+	cmd.RunE = c.lint
+
+	cmd.Flags().StringArray("old", nil, "The path to an old version of the schema. Repeat for multiple versions, from oldest to newest.")
+	cmd.Flags().String("new", "", "The path to the new version of the schema.")
+	compatibilities := make([]string, len(serdes.CompatibilityLevels))
+	for i, level := range serdes.CompatibilityLevels {
+		compatibilities[i] = strings.ToLower(level)
+	}
+	cmd.Flags().String("compatibility", "backward", fmt.Sprintf("Can be %s.", utils.ArrayToCommaDelimitedString(compatibilities, "or")))
+	pcmd.RegisterFlagCompletionFunc(cmd, "compatibility", func(_ *cobra.Command, _ []string) []string { return compatibilities })
+	pcmd.AddSchemaTypeFlag(cmd)
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagFilename("old", "avsc", "json", "proto"))
+	cobra.CheckErr(cmd.MarkFlagFilename("new", "avsc", "json", "proto"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("old"))
+	cobra.CheckErr(cmd.MarkFlagRequired("new"))
+
+	return cmd
+}
+
+func (c *lintCommand) lint(cmd *cobra.Command, _ []string) error {
+	oldSchemas, err := cmd.Flags().GetStringArray("old")
+	if err != nil {
+		return err
+	}
+
+	newSchema, err := cmd.Flags().GetString("new")
+	if err != nil {
+		return err
+	}
+
+	compatibility, err := cmd.Flags().GetString("compatibility")
+	if err != nil {
+		return err
+	}
+
+	schemaType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		return err
+	}
+	if schemaType == "" {
+		var ok bool
+		schemaType, ok = schemaTypesByExtension[filepath.Ext(newSchema)]
+		if !ok {
+			return fmt.Errorf("cannot infer the schema type of \"%s\": specify it with `--type`", newSchema)
+		}
+	}
+
+	incompatibilities, err := serdes.CheckCompatibility(strings.ToLower(schemaType), compatibility, oldSchemas, newSchema)
+	if err != nil {
+		return err
+	}
+
+	if len(incompatibilities) == 0 && output.GetFormat(cmd) == output.Human {
+		output.Printf(c.Config.EnableColor, "Schema \"%s\" is compatible with %s compatibility.\n", newSchema, strings.ToUpper(compatibility))
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(lintOut))
+	list.Sort(false)
+	for _, incompatibility := range incompatibilities {
+		list.Add(&lintOut{
+			OldSchema: incompatibility.OldSchema,
+			Direction: incompatibility.Direction,
+			Path:      incompatibility.Path,
+			Reason:    incompatibility.Reason,
+		})
+	}
+	if err := list.Print(); err != nil {
+		return err
+	}
+
+	if len(incompatibilities) > 0 {
+		return fmt.Errorf("found %d incompatible change(s) with %s compatibility", len(incompatibilities), strings.ToUpper(compatibility))
+	}
+	return nil
+}
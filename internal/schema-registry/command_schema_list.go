@@ -92,6 +92,7 @@ func (c *command) schemaList(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(row))
 	for _, schema := range schemas {
 		list.Add(&row{
 			SchemaId: schema.Id,
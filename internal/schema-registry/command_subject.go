@@ -15,6 +15,8 @@ func (c *command) newSubjectCommand(cfg *config.Config) *cobra.Command {
 	}
 
 	cmd.AddCommand(c.newSubjectDescribeCommand(cfg))
+	cmd.AddCommand(c.newSubjectExportCommand(cfg))
+	cmd.AddCommand(c.newSubjectImportCommand(cfg))
 	cmd.AddCommand(c.newSubjectListCommand(cfg))
 	cmd.AddCommand(c.newSubjectUpdateCommand(cfg))
 
@@ -74,6 +74,7 @@ func (c *command) subjectDescribe(cmd *cobra.Command, args []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(versionOut))
 	for _, version := range versions {
 		list.Add(&versionOut{Version: version})
 	}
@@ -0,0 +1,156 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/antihax/optional"
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+)
+
+// subjectExport is the file written for each subject by `confluent schema-registry subject export`. The subject-level
+// compatibility and mode are only set if they override the global ones.
+type subjectExport struct {
+	Subject  string         `json:"subject"`
+	Config   *srsdk.Config  `json:"config,omitempty"`
+	Mode     string         `json:"mode,omitempty"`
+	Versions []srsdk.Schema `json:"versions"`
+}
+
+type subjectExportOut struct {
+	Subject  string `human:"Subject" serialized:"subject"`
+	Versions int    `human:"Versions" serialized:"versions"`
+	File     string `human:"File" serialized:"file"`
+}
+
+func (c *command) newSubjectExportCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export subjects to files.",
+		Long:  "Export all schema versions of subjects, including their references, metadata, and rulesets, along with the subject-level compatibility and mode, to one file per subject.",
+		Args:  cobra.NoArgs,
+		RunE:  c.subjectExport,
+	}
+
+	example := examples.Example{
+		Text: `Export all subjects starting with "payments" to the directory "backup".`,
+		Code: "confluent schema-registry subject export --prefix payments --dir backup",
+	}
+	if cfg.IsOnPremLogin() {
+		example.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example)
+
+	cmd.Flags().String("prefix", ":*:", "Subject prefix.")
+	cmd.Flags().String("dir", "", "The directory to export the subjects to.")
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationFlag(cmd)
+		addSchemaRegistryEndpointFlag(cmd)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagDirname("dir"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("dir"))
+
+	return cmd
+}
+
+func (c *command) subjectExport(cmd *cobra.Command, _ []string) error {
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return err
+	}
+
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	schemas, err := client.GetSchemas(&srsdk.GetSchemasOpts{SubjectPrefix: optional.NewString(prefix)})
+	if err != nil {
+		return err
+	}
+
+	exports := make(map[string]*subjectExport)
+	for _, schema := range schemas {
+		if _, ok := exports[schema.Subject]; !ok {
+			exports[schema.Subject] = &subjectExport{Subject: schema.Subject}
+		}
+		exports[schema.Subject].Versions = append(exports[schema.Subject].Versions, schema)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(subjectExportOut))
+	for _, export := range exports {
+		slices.SortFunc(export.Versions, func(a, b srsdk.Schema) int { return int(a.Version - b.Version) })
+
+		if err := getSubjectOverrides(export, client); err != nil {
+			return err
+		}
+
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		file := getSubjectExportFileName(export.Subject)
+		if err := os.WriteFile(filepath.Join(dir, file), data, 0644); err != nil {
+			return err
+		}
+
+		list.Add(&subjectExportOut{
+			Subject:  export.Subject,
+			Versions: len(export.Versions),
+			File:     file,
+		})
+	}
+	return list.Print()
+}
+
+// getSubjectOverrides sets the compatibility and mode of a subject, if they are set at the subject level.
+func getSubjectOverrides(export *subjectExport, client *schemaregistry.Client) error {
+	subjectConfig, err := client.GetSubjectLevelConfig(export.Subject)
+	if err != nil && !schemaregistry.IsNotFoundError(err) {
+		return err
+	}
+	if err == nil {
+		export.Config = &subjectConfig
+	}
+
+	mode, err := client.GetMode(export.Subject)
+	if err != nil && !schemaregistry.IsNotFoundError(err) {
+		return err
+	}
+	export.Mode = mode.Mode
+
+	return nil
+}
+
+// getSubjectExportFileName escapes the subject, which may contain a context such as ":.staging:", to a portable file name.
+func getSubjectExportFileName(subject string) string {
+	return url.QueryEscape(subject) + ".json"
+}
@@ -0,0 +1,271 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/config"
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+)
+
+const (
+	importMode    = "IMPORT"
+	readWriteMode = "READWRITE"
+)
+
+type subjectImportOut struct {
+	Subject    string `human:"Subject" serialized:"subject"`
+	Version    int32  `human:"Version" serialized:"version"`
+	OriginalId int32  `human:"Original ID" serialized:"original_id"`
+	Id         int32  `human:"ID" serialized:"id"`
+}
+
+func (c *command) newSubjectImportCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import subjects from files.",
+		Long:  "Import subjects exported with `confluent schema-registry subject export`, registering all of their schema versions and restoring the subject-level compatibility and mode. Schemas are registered in the order of their original IDs, so that references are registered first.",
+		Args:  cobra.NoArgs,
+		RunE:  c.subjectImport,
+	}
+
+	example1 := examples.Example{
+		Text: `Import the subjects exported to the directory "backup".`,
+		Code: "confluent schema-registry subject import --dir backup",
+	}
+	example2 := examples.Example{
+		Text: `Import the subjects exported to the directory "backup", keeping their schema IDs and versions. The subjects must not exist yet.`,
+		Code: "confluent schema-registry subject import --dir backup --preserve-ids",
+	}
+	if cfg.IsOnPremLogin() {
+		example1.Code += " " + onPremAuthenticationMsg
+		example2.Code += " " + onPremAuthenticationMsg
+	}
+	cmd.Example = examples.BuildExampleString(example1, example2)
+
+	cmd.Flags().String("dir", "", "The directory to import the subjects from.")
+	cmd.Flags().Bool("preserve-ids", false, `Register each schema with its original ID and version, by switching each subject to "IMPORT" mode while importing.`)
+	pcmd.AddContextFlag(cmd, c.CLICommand)
+	if cfg.IsCloudLogin() {
+		pcmd.AddEnvironmentFlag(cmd, c.AuthenticatedCLICommand)
+	} else {
+		addCaLocationFlag(cmd)
+		addSchemaRegistryEndpointFlag(cmd)
+	}
+	pcmd.AddOutputFlag(cmd)
+
+	cobra.CheckErr(cmd.MarkFlagDirname("dir"))
+
+	cobra.CheckErr(cmd.MarkFlagRequired("dir"))
+
+	return cmd
+}
+
+func (c *command) subjectImport(cmd *cobra.Command, _ []string) (err error) {
+	dir, err := cmd.Flags().GetString("dir")
+	if err != nil {
+		return err
+	}
+
+	preserveIds, err := cmd.Flags().GetBool("preserve-ids")
+	if err != nil {
+		return err
+	}
+
+	exports, err := readSubjectExports(dir)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.GetSchemaRegistryClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Subjects switched to IMPORT mode are switched back to READWRITE mode if the import fails, so that they can be written to.
+	importing := make(map[string]bool)
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, export := range exports {
+			if !importing[export.Subject] {
+				continue
+			}
+			if _, restoreErr := client.UpdateMode(export.Subject, srsdk.ModeUpdateRequest{Mode: readWriteMode}); restoreErr != nil {
+				output.ErrPrintf(c.Config.EnableColor, "Failed to restore subject \"%s\" to %s mode: %v\n", export.Subject, readWriteMode, restoreErr)
+			}
+		}
+	}()
+
+	if preserveIds {
+		for _, export := range exports {
+			if _, err := client.UpdateMode(export.Subject, srsdk.ModeUpdateRequest{Mode: importMode}); err != nil {
+				return errors.NewErrorWithSuggestions(
+					fmt.Sprintf(`failed to set subject "%s" to %s mode: %v`, export.Subject, importMode, err),
+					"Schema IDs can only be preserved for subjects which do not exist yet. Import without `--preserve-ids` to assign new schema IDs.",
+				)
+			}
+			importing[export.Subject] = true
+		}
+	}
+
+	var schemas []srsdk.Schema
+	for _, export := range exports {
+		schemas = append(schemas, export.Versions...)
+	}
+	slices.SortStableFunc(schemas, func(a, b srsdk.Schema) int { return int(a.Id - b.Id) })
+
+	imported, err := registerSchemas(client, schemas, preserveIds)
+	if err != nil {
+		return err
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(subjectImportOut))
+	list.Sort(false)
+	for _, out := range imported {
+		list.Add(out)
+	}
+
+	for _, export := range exports {
+		if err := setSubjectOverrides(export, preserveIds, client); err != nil {
+			return err
+		}
+		delete(importing, export.Subject)
+	}
+
+	return list.Print()
+}
+
+type subjectVersion struct {
+	subject string
+	version int32
+}
+
+// registerSchemas registers schemas in order, so that references are registered before the schemas which use them.
+// Without preserving IDs, the versions assigned to referenced schemas may differ from the exported versions, so they
+// are looked up after registering and the references to them are rewritten.
+func registerSchemas(client *schemaregistry.Client, schemas []srsdk.Schema, preserveIds bool) ([]*subjectImportOut, error) {
+	referenced := make(map[subjectVersion]bool)
+	for _, schema := range schemas {
+		for _, reference := range schema.References {
+			referenced[subjectVersion{subject: reference.Subject, version: reference.Version}] = true
+		}
+	}
+
+	versions := make(map[subjectVersion]int32)
+	imported := make([]*subjectImportOut, len(schemas))
+	for i, schema := range schemas {
+		req := srsdk.RegisterSchemaRequest{
+			Schema:     schema.Schema,
+			SchemaType: schema.SchemaType,
+			References: schema.References,
+			Metadata:   schema.Metadata,
+			RuleSet:    schema.Ruleset,
+		}
+		if preserveIds {
+			req.Id = schema.Id
+			req.Version = schema.Version
+		} else {
+			req.References = make([]srsdk.SchemaReference, len(schema.References))
+			for j, reference := range schema.References {
+				if version, ok := versions[subjectVersion{subject: reference.Subject, version: reference.Version}]; ok {
+					reference.Version = version
+				}
+				req.References[j] = reference
+			}
+		}
+
+		res, err := client.Register(schema.Subject, req, nil)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to register version %d of subject "%s": %w`, schema.Version, schema.Subject, err)
+		}
+
+		key := subjectVersion{subject: schema.Subject, version: schema.Version}
+		if !preserveIds && referenced[key] {
+			registered, err := client.LookUpSchemaUnderSubject(schema.Subject, req, false)
+			if err != nil {
+				return nil, err
+			}
+			versions[key] = registered.Version
+		}
+
+		imported[i] = &subjectImportOut{
+			Subject:    schema.Subject,
+			Version:    schema.Version,
+			OriginalId: schema.Id,
+			Id:         res.Id,
+		}
+	}
+
+	return imported, nil
+}
+
+func readSubjectExports(dir string) ([]*subjectExport, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf(`no exported subjects found in directory "%s"`, dir)
+	}
+
+	exports := make([]*subjectExport, len(files))
+	for i, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		exports[i] = new(subjectExport)
+		if err := json.Unmarshal(data, exports[i]); err != nil {
+			return nil, fmt.Errorf(`failed to read exported subject "%s": %w`, file, err)
+		}
+		for j := range exports[i].Versions {
+			exports[i].Versions[j].Subject = exports[i].Subject
+		}
+	}
+	return exports, nil
+}
+
+// setSubjectOverrides restores the compatibility and mode of a subject. The mode is set last, since it may prevent
+// further changes, and a subject switched to IMPORT mode to preserve IDs is switched back to READWRITE mode.
+func setSubjectOverrides(export *subjectExport, preserveIds bool, client *schemaregistry.Client) error {
+	if export.Config != nil {
+		req := srsdk.ConfigUpdateRequest{
+			Compatibility:      export.Config.CompatibilityLevel,
+			CompatibilityGroup: export.Config.CompatibilityGroup,
+			DefaultMetadata:    export.Config.DefaultMetadata,
+			OverrideMetadata:   export.Config.OverrideMetadata,
+			DefaultRuleSet:     export.Config.DefaultRuleSet,
+			OverrideRuleSet:    export.Config.OverrideRuleSet,
+		}
+		if _, err := client.UpdateSubjectLevelConfig(export.Subject, req); err != nil {
+			return err
+		}
+	}
+
+	mode := export.Mode
+	if mode == "" && preserveIds {
+		mode = readWriteMode
+	}
+	if mode != "" {
+		if _, err := client.UpdateMode(export.Subject, srsdk.ModeUpdateRequest{Mode: mode}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
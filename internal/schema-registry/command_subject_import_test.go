@@ -0,0 +1,53 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+
+	schemaregistry "github.com/confluentinc/cli/v3/pkg/schema-registry"
+)
+
+func TestRegisterSchemas_References(t *testing.T) {
+	// The target already has a version of subject "ref", so the imported versions of "ref" are assigned versions 2 and 3.
+	registered := map[string][]srsdk.RegisterSchemaRequest{"ref": {{Schema: `"bytes"`}}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var req srsdk.RegisterSchemaRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+
+		subject, isRegister := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/subjects/"), "/versions")
+		if isRegister {
+			registered[subject] = append(registered[subject], req)
+			require.NoError(t, json.NewEncoder(w).Encode(srsdk.RegisterSchemaResponse{Id: int32(len(registered[subject]))}))
+			return
+		}
+
+		for i, schema := range registered[subject] {
+			if schema.Schema == req.Schema {
+				require.NoError(t, json.NewEncoder(w).Encode(srsdk.Schema{Subject: subject, Version: int32(i + 1), Schema: schema.Schema}))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := schemaregistry.NewClient(&srsdk.Configuration{BasePath: server.URL})
+
+	schemas := []srsdk.Schema{
+		{Subject: "ref", Version: 1, Id: 1, Schema: `"int"`},
+		{Subject: "ref", Version: 2, Id: 2, Schema: `"string"`},
+		{Subject: "value", Version: 1, Id: 3, Schema: `"value"`, References: []srsdk.SchemaReference{{Name: "ref", Subject: "ref", Version: 2}}},
+	}
+	_, err := registerSchemas(client, schemas, false)
+	require.NoError(t, err)
+	require.Equal(t, []srsdk.SchemaReference{{Name: "ref", Subject: "ref", Version: 3}}, registered["value"][0].References)
+}
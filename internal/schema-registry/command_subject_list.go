@@ -73,6 +73,7 @@ func (c *command) subjectList(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(subjectListOut))
 	for _, subject := range subjects {
 		list.Add(&subjectListOut{Subject: subject})
 	}
@@ -19,7 +19,7 @@ import (
 )
 
 type registerSchemaResponse struct {
-	Id int32 `json:"id" yaml:"id"`
+	Id int32 `human:"ID" json:"id" yaml:"id"`
 }
 
 type RegisterSchemaConfigs struct {
@@ -58,7 +58,13 @@ func RegisterSchemaWithAuth(cmd *cobra.Command, schemaCfg *RegisterSchemaConfigs
 		return 0, err
 	}
 
-	if output.GetFormat(cmd).IsSerialized() {
+	if format := output.GetFormat(cmd); format.IsDelimited() {
+		table := output.NewTable(cmd)
+		table.Add(&registerSchemaResponse{Id: response.Id})
+		if err := table.Print(); err != nil {
+			return 0, err
+		}
+	} else if format.IsSerialized() {
 		if err := output.SerializedOutput(cmd, &registerSchemaResponse{Id: response.Id}); err != nil {
 			return 0, err
 		}
@@ -69,6 +75,27 @@ func RegisterSchemaWithAuth(cmd *cobra.Command, schemaCfg *RegisterSchemaConfigs
 	return response.Id, nil
 }
 
+// LookUpSchemaWithAuth returns the ID of a schema which is already registered under the subject, without registering it.
+func LookUpSchemaWithAuth(schemaCfg *RegisterSchemaConfigs, client *schemaregistry.Client) (int32, error) {
+	schema, err := os.ReadFile(schemaCfg.SchemaPath)
+	if err != nil {
+		return 0, err
+	}
+
+	request := srsdk.RegisterSchemaRequest{
+		Schema:     string(schema),
+		SchemaType: schemaCfg.SchemaType,
+		References: schemaCfg.Refs,
+	}
+
+	response, err := client.LookUpSchemaUnderSubject(schemaCfg.Subject, request, schemaCfg.Normalize)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.Id, nil
+}
+
 func ReadSchemaReferences(cmd *cobra.Command, isKey bool) ([]srsdk.SchemaReference, error) {
 	name := "references"
 	if isKey {
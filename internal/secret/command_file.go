@@ -8,7 +8,7 @@ import (
 	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
 )
 
-const masterKeyNotSetWarning = "This command fails if a master key has not been set in the environment variable `CONFLUENT_SECURITY_MASTER_KEY`. Create a master key using `confluent secret master-key generate`."
+const masterKeyNotSetWarning = "This command fails if the master key cannot be read from the backend recorded in the local secrets file, which by default is the environment variable `CONFLUENT_SECURITY_MASTER_KEY`. Create a master key using `confluent secret master-key generate`."
 
 func (c *command) newFileCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -19,8 +19,10 @@ func (c *command) newFileCommand() *cobra.Command {
 	cmd.AddCommand(c.newAddCommand())
 	cmd.AddCommand(c.newDecryptCommand())
 	cmd.AddCommand(c.newEncryptCommand())
+	cmd.AddCommand(c.newMigrateCommand())
 	cmd.AddCommand(c.newRemoveCommand())
 	cmd.AddCommand(c.newRotateCommand())
+	cmd.AddCommand(c.newScanCommand())
 	cmd.AddCommand(c.newUpdateCommand())
 
 	return cmd
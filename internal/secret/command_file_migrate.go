@@ -0,0 +1,50 @@
+package secret
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+func (c *command) newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate a secrets file to authenticated encryption.",
+		Long:  "This command re-encrypts the secrets in the file specified by `--local-secrets-file` which use the legacy AES/CBC/PKCS5Padding algorithm with AES/GCM/NoPadding, which also protects their integrity. A new data key is generated for the migrated file. " + masterKeyNotSetWarning,
+		Args:  cobra.NoArgs,
+		RunE:  c.migrate,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Code: "confluent secret file migrate --local-secrets-file /path/to/secrets.properties",
+			},
+		),
+	}
+
+	cmd.Flags().String("local-secrets-file", "", "Path to the local encrypted configuration properties file.")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("local-secrets-file"))
+
+	return cmd
+}
+
+func (c *command) migrate(cmd *cobra.Command, _ []string) error {
+	localSecretsFile, err := cmd.Flags().GetString("local-secrets-file")
+	if err != nil {
+		return err
+	}
+
+	count, err := c.plugin.MigrateSecrets(localSecretsFile)
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		output.Printf(c.Config.EnableColor, "Secrets file \"%s\" already uses %s.\n", localSecretsFile, secret.AesGcm)
+		return nil
+	}
+
+	output.Printf(c.Config.EnableColor, "Migrated %d cipher(s) in secrets file \"%s\" to %s.\n", count, localSecretsFile, secret.AesGcm)
+	return nil
+}
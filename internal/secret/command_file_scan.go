@@ -0,0 +1,112 @@
+package secret
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	pcmd "github.com/confluentinc/cli/v3/pkg/cmd"
+	"github.com/confluentinc/cli/v3/pkg/examples"
+	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+)
+
+type scanOut struct {
+	File      string `human:"File" serialized:"file"`
+	Config    string `human:"Config" serialized:"config"`
+	Encrypted bool   `human:"Encrypted" serialized:"encrypted"`
+}
+
+func (c *command) newScanCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan <path-1> [path-2] ... [path-n]",
+		Short: "Find plaintext secrets in configuration files.",
+		Long:  "This command walks the given files and directories, and lists the password configs of properties files (including the options of embedded JAAS configurations) and standalone JAAS files (ending in \".conf\" or \".jaas\") which are not encrypted. The secrets files given by `--local-secrets-file` and `--remote-secrets-file` are skipped. With `--fix`, the plaintext secrets of properties files are encrypted as by `confluent secret file encrypt`; standalone JAAS files must be fixed by hand. " + masterKeyNotSetWarning,
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  c.scan,
+		Example: examples.BuildExampleString(
+			examples.Example{
+				Text: `List the plaintext secrets in the configuration files under "/etc/kafka".`,
+				Code: "confluent secret file scan /etc/kafka",
+			},
+			examples.Example{
+				Text: `Encrypt the plaintext secrets in the properties files under "/etc/kafka".`,
+				Code: "confluent secret file scan /etc/kafka --fix --local-secrets-file /etc/kafka/secrets.properties --remote-secrets-file /etc/kafka/secrets.properties",
+			},
+		),
+	}
+
+	cmd.Flags().Bool("fix", false, "Encrypt the plaintext secrets of properties files.")
+	cmd.Flags().String("local-secrets-file", "", "Path to the local encrypted configuration properties file.")
+	cmd.Flags().String("remote-secrets-file", "", "Path to the remote encrypted configuration properties file.")
+	pcmd.AddOutputFlag(cmd)
+
+	cmd.MarkFlagsRequiredTogether("fix", "local-secrets-file", "remote-secrets-file")
+
+	return cmd
+}
+
+func (c *command) scan(cmd *cobra.Command, args []string) error {
+	fix, err := cmd.Flags().GetBool("fix")
+	if err != nil {
+		return err
+	}
+
+	localSecretsFile, err := cmd.Flags().GetString("local-secrets-file")
+	if err != nil {
+		return err
+	}
+
+	remoteSecretsFile, err := cmd.Flags().GetString("remote-secrets-file")
+	if err != nil {
+		return err
+	}
+
+	secrets, err := secret.ScanConfigFiles(args, []string{localSecretsFile, remoteSecretsFile})
+	if err != nil {
+		return err
+	}
+
+	encrypted := make(map[*secret.PlaintextSecret]bool)
+	if fix {
+		for path, fileSecrets := range groupEncryptableSecrets(secrets) {
+			keys := make([]string, len(fileSecrets))
+			for i, fileSecret := range fileSecrets {
+				keys[i] = fileSecret.Key
+			}
+			if err := c.plugin.EncryptConfigFileSecrets(path, localSecretsFile, remoteSecretsFile, strings.Join(keys, ",")); err != nil {
+				return fmt.Errorf(`failed to encrypt secrets in "%s": %w`, path, err)
+			}
+			for _, fileSecret := range fileSecrets {
+				encrypted[fileSecret] = true
+			}
+		}
+	}
+
+	if output.GetFormat(cmd) == output.Human && len(secrets) == 0 {
+		output.Println(c.Config.EnableColor, "No plaintext secrets found.")
+		return nil
+	}
+
+	list := output.NewList(cmd)
+	list.Columns(new(scanOut))
+	for _, plaintextSecret := range secrets {
+		list.Add(&scanOut{
+			File:      plaintextSecret.Path,
+			Config:    plaintextSecret.Key,
+			Encrypted: encrypted[plaintextSecret],
+		})
+	}
+	return list.Print()
+}
+
+func groupEncryptableSecrets(secrets []*secret.PlaintextSecret) map[string][]*secret.PlaintextSecret {
+	files := make(map[string][]*secret.PlaintextSecret)
+	for _, plaintextSecret := range secrets {
+		if plaintextSecret.Encryptable {
+			files[plaintextSecret.Path] = append(files[plaintextSecret.Path], plaintextSecret)
+		}
+	}
+	return files
+}
@@ -9,13 +9,15 @@ import (
 	"github.com/confluentinc/cli/v3/pkg/errors"
 	"github.com/confluentinc/cli/v3/pkg/examples"
 	"github.com/confluentinc/cli/v3/pkg/output"
+	"github.com/confluentinc/cli/v3/pkg/secret"
+	"github.com/confluentinc/cli/v3/pkg/utils"
 )
 
 func (c *command) newGenerateFunction() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate a master key for Confluent Platform.",
-		Long:  "This command generates a master key. This key is used for encryption and decryption of configuration values.",
+		Long:  "This command generates a master key. This key is used for encryption and decryption of configuration values.\n\nBy default, the master key is read from the environment variable `CONFLUENT_SECURITY_MASTER_KEY`. Use `--backend` to store it in a key file or a HashiCorp Vault secret instead, or to read it from the output of a command. The command of the \"command\" backend is read from `--backend-location` or the environment variable `CONFLUENT_SECURITY_MASTER_KEY_COMMAND`, and later commands always read it from the environment variable. The address of the Vault server of the \"vault\" backend is read from the environment variable `VAULT_ADDR`. The backend is recorded in the local secrets file and used by the `confluent secret file` commands.",
 		Args:  cobra.NoArgs,
 		RunE:  c.generate,
 		Example: examples.BuildExampleString(
@@ -27,11 +29,19 @@ func (c *command) newGenerateFunction() *cobra.Command {
 				Text: `Read the passphrase from the file "/User/bob/secret.properties":`,
 				Code: "confluent secret master-key generate --local-secrets-file /path/to/secrets.txt --passphrase @/User/bob/secret.properties",
 			},
+			examples.Example{
+				Text: `Store the master key in the HashiCorp Vault secret "secret/confluent", using the server in the environment variable "VAULT_ADDR" and the token in the environment variable "VAULT_TOKEN":`,
+				Code: "confluent secret master-key generate --local-secrets-file /path/to/secrets.txt --passphrase - --backend vault --backend-location secret/data/confluent",
+			},
 		),
 	}
 
 	cmd.Flags().String("local-secrets-file", "", "Path to the local encrypted configuration properties file.")
 	cmd.Flags().String("passphrase", "", "The key passphrase.")
+	cmd.Flags().String("backend", secret.EnvBackend, fmt.Sprintf("The backend of the master key, one of %s.", utils.ArrayToCommaDelimitedString(secret.SecretBackends, "or")))
+	cmd.Flags().String("backend-location", "", `The environment variable for the "env" backend, key file for the "file" backend, command line for the "command" backend (which is not saved), or secret path for the "vault" backend.`)
+
+	pcmd.RegisterFlagCompletionFunc(cmd, "backend", func(_ *cobra.Command, _ []string) []string { return secret.SecretBackends })
 
 	cobra.CheckErr(cmd.MarkFlagRequired("local-secrets-file"))
 
@@ -61,11 +71,30 @@ func (c *command) generate(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	masterKey, err := c.plugin.CreateMasterKey(passphrase, localSecretsFile)
+	backendName, err := cmd.Flags().GetString("backend")
 	if err != nil {
 		return err
 	}
 
+	backendLocation, err := cmd.Flags().GetString("backend-location")
+	if err != nil {
+		return err
+	}
+
+	backend, err := secret.NewSecretBackend(backendName, backendLocation)
+	if err != nil {
+		return err
+	}
+
+	masterKey, err := c.plugin.CreateMasterKey(passphrase, localSecretsFile, backend)
+	if err != nil {
+		return err
+	}
+
+	if backend.Name() == secret.FileBackend || backend.Name() == secret.VaultBackend {
+		output.ErrPrintf(c.Config.EnableColor, "Stored the master key in the %s backend at \"%s\".\n", backend.Name(), backend.Location())
+	}
+
 	output.ErrPrintln(c.Config.EnableColor, errors.SaveTheMasterKeyMsg)
 	table := output.NewTable(cmd)
 	table.Add(&rotateOut{MasterKey: masterKey})
@@ -40,6 +40,7 @@ func (c *command) listConsumerShares(cmd *cobra.Command, _ []string) error {
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(consumerShareSerializedOut))
 	for _, share := range consumerShares {
 		if output.GetFormat(cmd) == output.Human {
 			list.Add(&consumerShareHumanOut{
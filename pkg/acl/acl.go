@@ -64,6 +64,7 @@ var DeleteACLConfirmMsg = "Are you sure you want to delete the %s corresponding
 
 func PrintACLsFromKafkaRestResponseOnPrem(cmd *cobra.Command, acls []cpkafkarestv3.AclData) error {
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, acl := range acls {
 		list.Add(&out{
 			Principal:    acl.Principal,
@@ -80,6 +81,7 @@ func PrintACLsFromKafkaRestResponseOnPrem(cmd *cobra.Command, acls []cpkafkarest
 
 func PrintACLs(cmd *cobra.Command, acls []*ccstructs.ACLBinding) error {
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, acl := range acls {
 		list.Add(&out{
 			Principal:    acl.GetEntry().GetPrincipal(),
@@ -323,6 +325,7 @@ func PrintACLsFromKafkaRestResponse(cmd *cobra.Command, acls []cckafkarestv3.Acl
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(out))
 	for _, acl := range acls {
 		principal := acl.GetPrincipal()
 		if !all {
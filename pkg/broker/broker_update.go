@@ -50,6 +50,7 @@ func Update(cmd *cobra.Command, args []string, restClient *kafkarestv3.APIClient
 	}
 
 	list := output.NewList(cmd)
+	list.Columns(new(ConfigOut))
 	for _, config := range configs.Data {
 		list.Add(&ConfigOut{
 			Name:  config.Name,
@@ -1,9 +1,15 @@
 package ccloudv2
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	connectv1 "github.com/confluentinc/ccloud-sdk-go-v2/connect/v1"
 
@@ -93,3 +99,110 @@ func (c *Client) ValidateConnectorPlugin(pluginName, environmentId, kafkaCluster
 	resp, httpResp, err := c.ConnectClient.PluginsV1Api.ValidateConnectv1ConnectorPlugin(c.connectApiContext(), pluginName, environmentId, kafkaClusterId).RequestBody(configs).Execute()
 	return resp, errors.CatchCCloudV2Error(err, httpResp)
 }
+
+// ConnectorOffsets are the offsets of a connector, which the Connect SDK does not model yet.
+type ConnectorOffsets struct {
+	Id       string            `json:"id"`
+	Name     string            `json:"name"`
+	Offsets  []ConnectorOffset `json:"offsets"`
+	Metadata struct {
+		ObservedAt string `json:"observed_at"`
+	} `json:"metadata"`
+}
+
+// ConnectorOffset is the offset of a connector for a single partition. Its format depends on the connector.
+type ConnectorOffset struct {
+	Partition map[string]any `json:"partition" yaml:"partition"`
+	Offset    map[string]any `json:"offset" yaml:"offset"`
+}
+
+// ConnectorOffsetsRequest alters ("PATCH") or resets ("DELETE") the offsets of a connector.
+type ConnectorOffsetsRequest struct {
+	Type    string            `json:"type"`
+	Offsets []ConnectorOffset `json:"offsets,omitempty"`
+}
+
+type ConnectorOffsetsRequestStatus struct {
+	Request ConnectorOffsetsRequest `json:"request"`
+	Status  struct {
+		Phase   string `json:"phase"`
+		Message string `json:"message"`
+	} `json:"status"`
+	AppliedAt string `json:"applied_at"`
+}
+
+func (c *Client) RestartConnector(connectorName, environmentId, kafkaClusterId string, onlyFailed, includeTasks bool) error {
+	query := url.Values{}
+	query.Set("only_failed", strconv.FormatBool(onlyFailed))
+	query.Set("include_tasks", strconv.FormatBool(includeTasks))
+	return c.doConnectRequest(http.MethodPost, connectorPath(connectorName, environmentId, kafkaClusterId, "restart"), query, nil, nil)
+}
+
+func (c *Client) GetConnectorOffsets(connectorName, environmentId, kafkaClusterId string) (*ConnectorOffsets, error) {
+	offsets := new(ConnectorOffsets)
+	err := c.doConnectRequest(http.MethodGet, connectorPath(connectorName, environmentId, kafkaClusterId, "offsets"), nil, nil, offsets)
+	return offsets, err
+}
+
+func (c *Client) RequestConnectorOffsets(connectorName, environmentId, kafkaClusterId string, request ConnectorOffsetsRequest) (*ConnectorOffsetsRequestStatus, error) {
+	status := new(ConnectorOffsetsRequestStatus)
+	err := c.doConnectRequest(http.MethodPost, connectorPath(connectorName, environmentId, kafkaClusterId, "offsets", "request"), nil, request, status)
+	return status, err
+}
+
+func (c *Client) GetConnectorOffsetsRequestStatus(connectorName, environmentId, kafkaClusterId string) (*ConnectorOffsetsRequestStatus, error) {
+	status := new(ConnectorOffsetsRequestStatus)
+	err := c.doConnectRequest(http.MethodGet, connectorPath(connectorName, environmentId, kafkaClusterId, "offsets", "request", "status"), nil, nil, status)
+	return status, err
+}
+
+func connectorPath(connectorName, environmentId, kafkaClusterId string, segments ...string) string {
+	path := fmt.Sprintf("/connect/v1/environments/%s/clusters/%s/connectors/%s", url.PathEscape(environmentId), url.PathEscape(kafkaClusterId), url.PathEscape(connectorName))
+	return strings.Join(append([]string{path}, segments...), "/")
+}
+
+// doConnectRequest sends a request to an endpoint of the Connect API which is missing from the Connect SDK, with the
+// same server, HTTP client, and credentials as the SDK. A successful response is decoded into v, which may be nil.
+func (c *Client) doConnectRequest(method, path string, query url.Values, body, v any) error {
+	cfg := c.ConnectClient.GetConfig()
+
+	var reader io.Reader
+	if body != nil {
+		out, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(out)
+	}
+
+	u := cfg.Servers[0].URL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, u, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.cfg.Context().GetAuthToken())
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		return errors.CatchCCloudV2Error(fmt.Errorf("%s", httpResp.Status), httpResp)
+	}
+
+	if v == nil || httpResp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(httpResp.Body).Decode(v)
+}
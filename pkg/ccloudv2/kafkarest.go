@@ -119,6 +119,23 @@ func (c *KafkaRestClient) DeleteKafkaAcls(acl *ccstructs.ACLFilter) (kafkarestv3
 	return res, kafkarest.NewError(c.GetUrl(), err, httpResp)
 }
 
+func (c *KafkaRestClient) ListKafkaAcls() ([]kafkarestv3.AclData, error) {
+	res, httpResp, err := c.ACLV3Api.GetKafkaAcls(c.kafkaRestApiContext(), c.ClusterId).Execute()
+	if err != nil {
+		return nil, kafkarest.NewError(c.GetUrl(), err, httpResp)
+	}
+	return res.GetData(), nil
+}
+
+// DeleteKafkaAcl deletes the ACL which matches every field of the request, rather than every ACL matching a filter.
+func (c *KafkaRestClient) DeleteKafkaAcl(data kafkarestv3.CreateAclRequestData) error {
+	req := c.ACLV3Api.DeleteKafkaAcls(c.kafkaRestApiContext(), c.ClusterId).ResourceType(data.ResourceType).ResourceName(data.ResourceName).PatternType(data.PatternType)
+	req = req.Principal(data.Principal).Host(data.Host).Operation(data.Operation).Permission(data.Permission)
+
+	_, httpResp, err := req.Execute()
+	return kafkarest.NewError(c.GetUrl(), err, httpResp)
+}
+
 func (c *KafkaRestClient) CreateKafkaLink(linkName string, validateLink, validateOnly bool, data kafkarestv3.CreateLinkRequestData) error {
 	httpResp, err := c.ClusterLinkingV3Api.CreateKafkaLink(c.kafkaRestApiContext(), c.ClusterId).LinkName(linkName).ValidateLink(validateLink).ValidateOnly(validateOnly).CreateLinkRequestData(data).Execute()
 	return kafkarest.NewError(c.GetUrl(), err, httpResp)
@@ -418,6 +418,14 @@ func AddValueFormatFlag(cmd *cobra.Command) {
 	RegisterFlagCompletionFunc(cmd, "value-format", func(_ *cobra.Command, _ []string) []string { return serdes.Formats })
 }
 
+// InputFormats are the values of the "--input-format" flag of commands which produce messages.
+var InputFormats = []string{"delimited", "json"}
+
+func AddInputFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String("input-format", "delimited", fmt.Sprintf(`Format of each line of input as %s. With "json", each line is an object with optional "key", "value", "headers", "partition", and "timestamp" fields.`, utils.ArrayToCommaDelimitedString(InputFormats, "or")))
+	RegisterFlagCompletionFunc(cmd, "input-format", func(_ *cobra.Command, _ []string) []string { return InputFormats })
+}
+
 func AddLinkFlag(cmd *cobra.Command, command *AuthenticatedCLICommand) {
 	cmd.Flags().String("link", "", "Name of cluster link.")
 
@@ -26,3 +26,14 @@ func OnPremAuthenticationSet() *pflag.FlagSet {
 	set.SortFlags = false
 	return set
 }
+
+func OnPremConnectRestSet() *pflag.FlagSet {
+	set := pflag.NewFlagSet("onprem-connectrest", pflag.ExitOnError)
+	set.String("url", "", "Base URL of the REST API of a Kafka Connect worker. Must set flag or CONFLUENT_CONNECT_URL.")
+	set.String("ca-cert-path", "", "Path to a PEM-encoded CA to verify the Kafka Connect REST API.")
+	set.String("client-cert-path", "", "Path to client cert to be verified by the Kafka Connect REST API. Include for mTLS authentication.")
+	set.String("client-key-path", "", "Path to client private key, include for mTLS authentication.")
+	set.Bool("no-authentication", false, "Include if requests should be made without authentication headers.")
+	set.SortFlags = false
+	return set
+}
@@ -134,6 +134,11 @@ const (
 	InvalidMDSTokenErrorMsg           = "Invalid MDS token"
 	InvalidMDSTokenSuggestions        = "Re-login with `confluent login`."
 
+	// Kafka Connect REST errors
+	ConnectRestErrorMsg               = "Kafka Connect REST request failed: %s %s: %s"
+	ConnectRestUrlNotFoundErrorMsg    = "Kafka Connect REST URL not found"
+	ConnectRestUrlNotFoundSuggestions = "Use the `--url` flag or set `CONFLUENT_CONNECT_URL`."
+
 	// Special error handling
 	AvoidTimeoutSuggestions = "To avoid session timeouts, non-SSO users can save their credentials with `confluent login --save`."
 	NotLoggedInErrorMsg     = "not logged in"
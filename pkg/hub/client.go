@@ -14,6 +14,9 @@ type Client struct {
 	Debug     bool
 	UserAgent string
 	Client    *http.Client
+
+	// Mirror is the directory or URL of a Confluent Hub mirror, which the client reads from instead of the Confluent Hub API.
+	Mirror string
 }
 
 func NewClient(userAgent string, isTest, unsafeTrace bool) *Client {
@@ -32,3 +35,11 @@ func NewClient(userAgent string, isTest, unsafeTrace bool) *Client {
 		Client:    client.StandardClient(),
 	}
 }
+
+// NewMirrorClient creates a client for a Confluent Hub mirror, which is either a directory or the URL of a server
+// hosting a directory. See MirrorManifestPath for the layout of a mirror.
+func NewMirrorClient(mirror, userAgent string, unsafeTrace bool) *Client {
+	client := NewClient(userAgent, false, unsafeTrace)
+	client.Mirror = mirror
+	return client
+}
@@ -2,10 +2,12 @@ package hub
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httputil"
+	"os"
 
 	"github.com/confluentinc/cli/v3/pkg/cpstructs"
 	"github.com/confluentinc/cli/v3/pkg/log"
@@ -13,56 +15,52 @@ import (
 
 const clientNotInitializedErrorMsg = "Hub client not initialized"
 
+// notFoundError is returned when a plugin version does not exist on Confluent Hub or in a mirror.
+type notFoundError struct {
+	message string
+}
+
+func (e *notFoundError) Error() string {
+	return e.message
+}
+
+// IsNotFound returns whether an error was returned because a plugin version does not exist, rather than because
+// Confluent Hub or a mirror could not be reached.
+func IsNotFound(err error) bool {
+	var notFoundErr *notFoundError
+	return errors.As(err, &notFoundErr)
+}
+
 func (c *Client) GetRemoteManifest(owner, name, version string) (*cpstructs.Manifest, error) {
 	if c == nil {
 		return nil, fmt.Errorf(clientNotInitializedErrorMsg)
 	}
 
+	if c.Mirror != "" {
+		return c.getMirrorManifest(owner, name, version)
+	}
+
 	manifestUrl := fmt.Sprintf("%s/api/plugins/%s/%s", c.URL, owner, name)
 	if version != "latest" {
 		manifestUrl = fmt.Sprintf("%s/versions/%s", manifestUrl, version)
 	}
 
-	req, err := http.NewRequest(http.MethodGet, manifestUrl, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Add("User-Agent", c.UserAgent)
-
-	if c.Debug {
-		dump, err := httputil.DumpRequestOut(req, true)
-		if err != nil {
-			return nil, err
-		}
-		log.CliLogger.Trace(string(dump))
-	}
-
-	r, err := c.Client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer r.Body.Close()
-
-	if c.Debug {
-		dump, err := httputil.DumpResponse(r, true)
-		if err != nil {
-			return nil, err
-		}
-		log.CliLogger.Trace(string(dump))
-	}
-
-	body, err := io.ReadAll(r.Body)
+	body, statusCode, err := c.get(manifestUrl, true)
 	if err != nil {
 		return nil, err
 	}
 
-	if r.StatusCode != http.StatusOK {
+	if statusCode != http.StatusOK {
+		message := "failed to read manifest file from Confluent Hub"
 		response := make(map[string]interface{})
 		_ = json.Unmarshal(body, &response)
 		if errorMessage, ok := response["message"]; ok {
-			return nil, fmt.Errorf("failed to read manifest file from Confluent Hub: %s", errorMessage)
+			message = fmt.Sprintf("%s: %s", message, errorMessage)
+		}
+		if statusCode == http.StatusNotFound {
+			return nil, &notFoundError{message: message}
 		}
-		return nil, fmt.Errorf("failed to read manifest file from Confluent Hub")
+		return nil, errors.New(message)
 	}
 
 	pluginManifest := new(cpstructs.Manifest)
@@ -78,29 +76,63 @@ func (c *Client) GetRemoteArchive(pluginManifest *cpstructs.Manifest) ([]byte, e
 		return nil, fmt.Errorf(clientNotInitializedErrorMsg)
 	}
 
-	req, err := http.NewRequest(http.MethodGet, pluginManifest.Archive.Url, nil)
+	// Directory mirrors may keep the archive locations of Confluent Hub, so the location of the archive, rather than the
+	// mirror, determines whether it is downloaded or read from a file.
+	if !isUrl(pluginManifest.Archive.Url) {
+		archive, err := os.ReadFile(pluginManifest.Archive.Url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive from Confluent Hub mirror: %w", err)
+		}
+		return archive, nil
+	}
+
+	archive, statusCode, err := c.get(pluginManifest.Archive.Url, false)
 	if err != nil {
 		return nil, err
 	}
+
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to retrieve archive from Confuent Hub")
+	}
+
+	return archive, nil
+}
+
+// get sends a GET request. With --unsafe-trace, the response body is only logged if dumpBody is set, so that archives
+// are not logged.
+func (c *Client) get(url string, dumpBody bool) ([]byte, int, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
 	req.Header.Add("User-Agent", c.UserAgent)
 
 	if c.Debug {
 		dump, err := httputil.DumpRequestOut(req, true)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		log.CliLogger.Trace(string(dump))
 	}
 
 	r, err := c.Client.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer r.Body.Close()
 
-	if r.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to retrieve archive from Confuent Hub")
+	if c.Debug {
+		dump, err := httputil.DumpResponse(r, dumpBody)
+		if err != nil {
+			return nil, 0, err
+		}
+		log.CliLogger.Trace(string(dump))
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	return io.ReadAll(r.Body)
+	return body, r.StatusCode, nil
 }
@@ -0,0 +1,151 @@
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/cpstructs"
+)
+
+// A Confluent Hub mirror stores the manifest and archive of each version of a plugin in a directory:
+//
+//	<owner>/<name>/<version>/manifest.json
+//	<owner>/<name>/<version>/<archive>
+//	<owner>/<name>/latest/manifest.json
+//
+// The archive URL of each manifest is relative to the manifest, so the mirror can be copied to another machine or
+// hosted by any static file server. The checksums of each manifest are those published by Confluent Hub.
+
+const (
+	mirrorLatestVersion = "latest"
+	mirrorManifestFile  = "manifest.json"
+)
+
+// MirrorManifestPath returns the path of the manifest of a plugin version, relative to the root of a mirror.
+func MirrorManifestPath(owner, name, version string) string {
+	return path.Join(owner, name, version, mirrorManifestFile)
+}
+
+// MirrorArchivePath returns the path of the archive of a plugin version, relative to the root of a mirror.
+func MirrorArchivePath(pluginManifest *cpstructs.Manifest) string {
+	return path.Join(pluginManifest.Owner.Username, pluginManifest.Name, pluginManifest.Version, ArchiveFileName(pluginManifest))
+}
+
+// ArchiveFileName returns the name of the archive file of a plugin version, from its URL on Confluent Hub.
+func ArchiveFileName(pluginManifest *cpstructs.Manifest) string {
+	name := path.Base(pluginManifest.Archive.Url)
+	if u, err := url.Parse(pluginManifest.Archive.Url); err == nil && u.Path != "" {
+		name = path.Base(u.Path)
+	}
+	if name == "" || name == "." || name == "/" {
+		name = fmt.Sprintf("%s-%s-%s.zip", pluginManifest.Owner.Username, pluginManifest.Name, pluginManifest.Version)
+	}
+	return name
+}
+
+// WriteMirrorManifests writes the manifest of a plugin version to a mirror directory, with the archive URL relative to
+// the manifest, and if latest is set, also writes it as the latest version of the plugin.
+func WriteMirrorManifests(dir string, pluginManifest *cpstructs.Manifest, latest bool) error {
+	archiveFileName := ArchiveFileName(pluginManifest)
+
+	mirrorManifest := *pluginManifest
+	mirrorManifest.Archive.Url = archiveFileName
+	if err := writeMirrorManifest(dir, MirrorManifestPath(pluginManifest.Owner.Username, pluginManifest.Name, pluginManifest.Version), &mirrorManifest); err != nil {
+		return err
+	}
+
+	if !latest {
+		return nil
+	}
+
+	mirrorManifest.Archive.Url = path.Join("..", pluginManifest.Version, archiveFileName)
+	return writeMirrorManifest(dir, MirrorManifestPath(pluginManifest.Owner.Username, pluginManifest.Name, mirrorLatestVersion), &mirrorManifest)
+}
+
+func writeMirrorManifest(dir, manifestPath string, pluginManifest *cpstructs.Manifest) error {
+	out, err := json.MarshalIndent(pluginManifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	manifestPath = filepath.Join(dir, filepath.FromSlash(manifestPath))
+	if err := os.MkdirAll(filepath.Dir(manifestPath), 0755); err != nil {
+		return fmt.Errorf(`failed to create directory "%s": %w`, filepath.Dir(manifestPath), err)
+	}
+
+	return os.WriteFile(manifestPath, append(out, '\n'), 0644)
+}
+
+// getMirrorManifest reads the manifest of a plugin version from a mirror, and resolves its archive URL.
+func (c *Client) getMirrorManifest(owner, name, version string) (*cpstructs.Manifest, error) {
+	manifestPath := MirrorManifestPath(owner, name, version)
+
+	var body []byte
+	var archiveUrl func(string) string
+	if isUrl(c.Mirror) {
+		manifestUrl, err := url.JoinPath(c.Mirror, manifestPath)
+		if err != nil {
+			return nil, err
+		}
+
+		var statusCode int
+		body, statusCode, err = c.get(manifestUrl, true)
+		if err != nil {
+			return nil, err
+		}
+		if statusCode == http.StatusNotFound {
+			return nil, &notFoundError{message: fmt.Sprintf(`plugin "%s/%s:%s" not found in Confluent Hub mirror "%s"`, owner, name, version, c.Mirror)}
+		}
+		if statusCode != http.StatusOK {
+			return nil, fmt.Errorf(`failed to read manifest file from Confluent Hub mirror "%s": %s`, c.Mirror, http.StatusText(statusCode))
+		}
+
+		archiveUrl = func(ref string) string {
+			base, err := url.Parse(manifestUrl)
+			if err != nil {
+				return ref
+			}
+			refUrl, err := url.Parse(ref)
+			if err != nil {
+				return ref
+			}
+			return base.ResolveReference(refUrl).String()
+		}
+	} else {
+		manifestFile := filepath.Join(c.Mirror, filepath.FromSlash(manifestPath))
+
+		var err error
+		body, err = os.ReadFile(manifestFile)
+		if os.IsNotExist(err) {
+			return nil, &notFoundError{message: fmt.Sprintf(`plugin "%s/%s:%s" not found in Confluent Hub mirror "%s"`, owner, name, version, c.Mirror)}
+		}
+		if err != nil {
+			return nil, fmt.Errorf(`failed to read manifest file from Confluent Hub mirror "%s": %w`, c.Mirror, err)
+		}
+
+		archiveUrl = func(ref string) string {
+			if isUrl(ref) || filepath.IsAbs(ref) {
+				return ref
+			}
+			return filepath.Join(filepath.Dir(manifestFile), filepath.FromSlash(ref))
+		}
+	}
+
+	pluginManifest := new(cpstructs.Manifest)
+	if err := json.Unmarshal(body, pluginManifest); err != nil {
+		return nil, fmt.Errorf(`failed to parse manifest file from Confluent Hub mirror "%s": %w`, c.Mirror, err)
+	}
+	pluginManifest.Archive.Url = archiveUrl(pluginManifest.Archive.Url)
+
+	return pluginManifest, nil
+}
+
+func isUrl(mirror string) bool {
+	return strings.HasPrefix(mirror, "http://") || strings.HasPrefix(mirror, "https://")
+}
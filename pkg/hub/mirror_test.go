@@ -0,0 +1,117 @@
+package hub
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/cli/v3/pkg/cpstructs"
+	testserver "github.com/confluentinc/cli/v3/test/test-server"
+)
+
+func newTestManifest() *cpstructs.Manifest {
+	return &cpstructs.Manifest{
+		Name:    "kafka-connect-datagen",
+		Version: "0.6.2",
+		Owner:   cpstructs.Owner{Username: "confluentinc"},
+		Archive: cpstructs.Archive{
+			Url:  "https://api.hub.confluent.io/api/plugins/confluentinc/kafka-connect-datagen/versions/0.6.2/confluentinc-kafka-connect-datagen-0.6.2.zip",
+			Md5:  "md5",
+			Sha1: "sha1",
+		},
+	}
+}
+
+func writeTestMirror(t *testing.T) string {
+	dir := t.TempDir()
+	pluginManifest := newTestManifest()
+
+	require.NoError(t, WriteMirrorManifests(dir, pluginManifest, true))
+	archivePath := filepath.Join(dir, filepath.FromSlash(MirrorArchivePath(pluginManifest)))
+	require.NoError(t, os.WriteFile(archivePath, []byte("archive"), 0644))
+
+	return dir
+}
+
+func TestNewClient_Test(t *testing.T) {
+	require.Equal(t, "https://api.hub.confluent.io", NewClient("", false, false).URL)
+	require.Equal(t, testserver.TestHubUrl.String(), NewClient("", true, false).URL)
+}
+
+func TestMirrorArchivePath(t *testing.T) {
+	require.Equal(t, "confluentinc/kafka-connect-datagen/0.6.2/confluentinc-kafka-connect-datagen-0.6.2.zip", MirrorArchivePath(newTestManifest()))
+}
+
+func TestDirectoryMirror(t *testing.T) {
+	dir := writeTestMirror(t)
+	client := NewMirrorClient(dir, "", false)
+
+	for _, version := range []string{"0.6.2", "latest"} {
+		pluginManifest, err := client.GetRemoteManifest("confluentinc", "kafka-connect-datagen", version)
+		require.NoError(t, err)
+		require.Equal(t, "0.6.2", pluginManifest.Version)
+		require.Equal(t, "md5", pluginManifest.Archive.Md5)
+
+		archive, err := client.GetRemoteArchive(pluginManifest)
+		require.NoError(t, err)
+		require.Equal(t, "archive", string(archive))
+	}
+
+	_, err := client.GetRemoteManifest("confluentinc", "kafka-connect-jdbc", "latest")
+	require.Error(t, err)
+}
+
+func TestUrlMirror(t *testing.T) {
+	server := httptest.NewServer(http.StripPrefix("/mirror", http.FileServer(http.Dir(writeTestMirror(t)))))
+	defer server.Close()
+
+	client := NewMirrorClient(server.URL+"/mirror", "", false)
+
+	for _, version := range []string{"0.6.2", "latest"} {
+		pluginManifest, err := client.GetRemoteManifest("confluentinc", "kafka-connect-datagen", version)
+		require.NoError(t, err)
+		require.Equal(t, server.URL+"/mirror/confluentinc/kafka-connect-datagen/0.6.2/confluentinc-kafka-connect-datagen-0.6.2.zip", pluginManifest.Archive.Url)
+
+		archive, err := client.GetRemoteArchive(pluginManifest)
+		require.NoError(t, err)
+		require.Equal(t, "archive", string(archive))
+	}
+
+	_, err := client.GetRemoteManifest("confluentinc", "kafka-connect-jdbc", "latest")
+	require.Error(t, err)
+}
+
+func TestDirectoryMirror_AbsoluteArchive(t *testing.T) {
+	dir := t.TempDir()
+	archiveDir := t.TempDir()
+	archivePath := filepath.Join(archiveDir, "archive.zip")
+	require.NoError(t, os.WriteFile(archivePath, []byte("archive"), 0644))
+
+	server := httptest.NewServer(http.FileServer(http.Dir(archiveDir)))
+	defer server.Close()
+
+	for _, archiveUrl := range []string{server.URL + "/archive.zip", archivePath} {
+		pluginManifest := newTestManifest()
+		pluginManifest.Archive.Url = archiveUrl
+
+		body, err := json.Marshal(pluginManifest)
+		require.NoError(t, err)
+		manifestFile := filepath.Join(dir, filepath.FromSlash(MirrorManifestPath("confluentinc", "kafka-connect-datagen", "0.6.2")))
+		require.NoError(t, os.MkdirAll(filepath.Dir(manifestFile), 0755))
+		require.NoError(t, os.WriteFile(manifestFile, body, 0644))
+
+		client := NewMirrorClient(dir, "", false)
+		pluginManifest, err = client.GetRemoteManifest("confluentinc", "kafka-connect-datagen", "0.6.2")
+		require.NoError(t, err)
+		require.Equal(t, archiveUrl, pluginManifest.Archive.Url)
+
+		archive, err := client.GetRemoteArchive(pluginManifest)
+		require.NoError(t, err)
+		require.Equal(t, "archive", string(archive))
+	}
+}
@@ -15,5 +15,5 @@ func (h FieldHider) MakeTag(t reflect.Type, i int) reflect.StructTag {
 	if *h.filter == nil || slices.Contains(*h.filter, t.Field(i).Name) {
 		return t.Field(i).Tag
 	}
-	return reflect.StructTag(fmt.Sprintf(`%s:"-"`, h.format))
+	return reflect.StructTag(fmt.Sprintf(`%s:"-"`, h.format.tagName()))
 }
@@ -11,7 +11,7 @@ type FieldSerializer struct {
 
 func (s FieldSerializer) MakeTag(t reflect.Type, i int) reflect.StructTag {
 	if val, ok := t.Field(i).Tag.Lookup("serialized"); ok {
-		return reflect.StructTag(fmt.Sprintf(`%s:"%s"`, s.format.String(), val))
+		return reflect.StructTag(fmt.Sprintf(`%s:"%s"`, s.format.tagName(), val))
 	}
 	return t.Field(i).Tag
 }
@@ -1,6 +1,13 @@
 package output
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+)
 
 type Format int
 
@@ -8,22 +15,40 @@ const (
 	Human Format = iota
 	JSON
 	YAML
+	CSV
+	TSV
+	NDJSON
+	GoTemplate
+	GoTemplateFile
+	JSONPath
 )
 
 const FlagName = "output"
 
-var ValidFlagValues = []string{"human", "json", "yaml"}
+var ValidFlagValues = []string{"human", "json", "yaml", "csv", "tsv", "ndjson", "go-template=", "go-template-file=", "jsonpath="}
 
 func GetFormat(cmd *cobra.Command) Format {
 	format, _ := cmd.Flags().GetString(FlagName)
 
-	switch format {
+	switch {
 	default:
 		return Human
-	case "json":
+	case format == "json":
 		return JSON
-	case "yaml":
+	case format == "yaml":
 		return YAML
+	case format == "csv":
+		return CSV
+	case format == "tsv":
+		return TSV
+	case format == "ndjson":
+		return NDJSON
+	case strings.HasPrefix(format, GoTemplate.String()):
+		return GoTemplate
+	case strings.HasPrefix(format, GoTemplateFile.String()):
+		return GoTemplateFile
+	case strings.HasPrefix(format, JSONPath.String()):
+		return JSONPath
 	}
 }
 
@@ -32,5 +57,38 @@ func (o Format) String() string {
 }
 
 func (o Format) IsSerialized() bool {
-	return o == JSON || o == YAML
+	return o == JSON || o == YAML || o == NDJSON || o.IsTemplate()
+}
+
+// IsDelimited returns true for formats which print one row per object, with columns separated by a delimiter.
+func (o Format) IsDelimited() bool {
+	return o == CSV || o == TSV
+}
+
+// ValidateNotDelimited returns an error if the output format is delimited, for commands whose output is not a single table.
+func ValidateNotDelimited(cmd *cobra.Command) error {
+	if format := GetFormat(cmd); format.IsDelimited() {
+		return errors.NewErrorWithSuggestions(
+			fmt.Sprintf(`this command does not support "%s" output`, format),
+			"Use `--output json` or `--output yaml` instead.",
+		)
+	}
+	return nil
+}
+
+// IsTemplate returns true for formats which evaluate a user-provided template against the JSON representation of the output.
+func (o Format) IsTemplate() bool {
+	return o == GoTemplate || o == GoTemplateFile || o == JSONPath
+}
+
+// tagName returns the struct tag which describes how fields are printed in this format.
+func (o Format) tagName() string {
+	switch {
+	case o.IsDelimited():
+		return Human.String()
+	case o == NDJSON || o.IsTemplate():
+		return JSON.String()
+	default:
+		return o.String()
+	}
 }
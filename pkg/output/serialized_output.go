@@ -2,14 +2,20 @@ package output
 
 import (
 	"encoding/json"
+	"os"
+	"reflect"
 
 	"github.com/spf13/cobra"
 	"github.com/tidwall/pretty"
 	"gopkg.in/yaml.v3"
 )
 
-// SerializedOutput - pretty prints an object in specified format (JSON or YAML) using tags specified in struct definition
+// SerializedOutput - pretty prints an object in specified format (JSON, YAML, NDJSON, or a template) using tags specified in struct definition
 func SerializedOutput(cmd *cobra.Command, v any) error {
+	if err := ValidateNotDelimited(cmd); err != nil {
+		return err
+	}
+
 	switch GetFormat(cmd) {
 	default:
 		out, err := json.Marshal(v)
@@ -23,6 +29,17 @@ func SerializedOutput(cmd *cobra.Command, v any) error {
 			return err
 		}
 		Print(false, string(out))
+	case NDJSON:
+		objects := []any{v}
+		if val := reflect.ValueOf(v); val.Kind() == reflect.Slice {
+			objects = make([]any, val.Len())
+			for i := range objects {
+				objects[i] = val.Index(i).Interface()
+			}
+		}
+		return writeNDJSON(os.Stdout, objects)
+	case GoTemplate, GoTemplateFile, JSONPath:
+		return writeTemplate(os.Stdout, GetFormat(cmd), getTemplate(cmd), v)
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package output
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -17,19 +18,22 @@ import (
 )
 
 type Table struct {
-	isList  bool
-	writer  io.Writer
-	format  Format
-	objects []any
-	filter  []string
-	sort    bool
+	isList   bool
+	writer   io.Writer
+	format   Format
+	template string
+	objects  []any
+	columns  any
+	filter   []string
+	sort     bool
 }
 
 // NewTable creates a table for printing a single object.
 func NewTable(cmd *cobra.Command) *Table {
 	return &Table{
-		writer: cmd.OutOrStdout(),
-		format: GetFormat(cmd),
+		writer:   cmd.OutOrStdout(),
+		format:   GetFormat(cmd),
+		template: getTemplate(cmd),
 	}
 }
 
@@ -49,6 +53,11 @@ func (t *Table) Add(i any) {
 	}
 }
 
+// Columns declares the type of the objects of a list, so that delimited formats can print a header even if the list is empty.
+func (t *Table) Columns(object any) {
+	t.columns = object
+}
+
 // Filter allows for printing a specific subset or ordering of fields
 func (t *Table) Filter(fields []string) {
 	t.filter = fields
@@ -82,13 +91,16 @@ func (t *Table) printCore(writer io.Writer, auto bool) error {
 			}
 		}
 
+		hider := FieldHider{
+			format: t.format,
+			filter: &t.filter,
+		}
 		for i := range t.objects {
-			hider := FieldHider{
-				format: t.format,
-				filter: &t.filter,
-			}
 			t.objects[i] = retag.Convert(t.objects[i], hider)
 		}
+		if t.columns != nil {
+			t.columns = retag.Convert(t.columns, hider)
+		}
 	}
 
 	if t.sort {
@@ -139,9 +151,17 @@ func (t *Table) printCore(writer io.Writer, auto bool) error {
 			}
 			_, err = writer.Write(out)
 			return err
+		case NDJSON:
+			return writeNDJSON(writer, t.objects)
+		case GoTemplate, GoTemplateFile, JSONPath:
+			return writeTemplate(writer, t.format, t.template, v)
 		}
 	}
 
+	if t.format.IsDelimited() {
+		return t.printDelimited(writer)
+	}
+
 	isEmpty := false
 	if t.isList {
 		isEmpty = len(t.objects) == 0
@@ -159,7 +179,7 @@ func (t *Table) printCore(writer io.Writer, auto bool) error {
 	if t.isList {
 		var header []string
 		for i := 0; i < reflect.TypeOf(t.objects[0]).Elem().NumField(); i++ {
-			tag := strings.Split(reflect.TypeOf(t.objects[0]).Elem().Field(i).Tag.Get(t.format.String()), ",")
+			tag := strings.Split(reflect.TypeOf(t.objects[0]).Elem().Field(i).Tag.Get(t.format.tagName()), ",")
 			if !slices.Contains(tag, "-") {
 				header = append(header, tag[0])
 			}
@@ -172,7 +192,7 @@ func (t *Table) printCore(writer io.Writer, auto bool) error {
 		for _, object := range t.objects {
 			var row []string
 			for i := 0; i < reflect.TypeOf(object).Elem().NumField(); i++ {
-				tag := strings.Split(reflect.TypeOf(object).Elem().Field(i).Tag.Get(t.format.String()), ",")
+				tag := strings.Split(reflect.TypeOf(object).Elem().Field(i).Tag.Get(t.format.tagName()), ",")
 				if !slices.Contains(tag, "-") {
 					val := reflect.ValueOf(object).Elem().Field(i)
 					row = append(row, getValueAsString(val, tag))
@@ -188,7 +208,7 @@ func (t *Table) printCore(writer io.Writer, auto bool) error {
 	} else {
 		w.SetAlignment(tablewriter.ALIGN_LEFT)
 		for i := 0; i < reflect.TypeOf(t.objects[0]).Elem().NumField(); i++ {
-			tag := strings.Split(reflect.TypeOf(t.objects[0]).Elem().Field(i).Tag.Get(t.format.String()), ",")
+			tag := strings.Split(reflect.TypeOf(t.objects[0]).Elem().Field(i).Tag.Get(t.format.tagName()), ",")
 			val := reflect.ValueOf(t.objects[0]).Elem().Field(i)
 			if !slices.Contains(tag, "-") && !(slices.Contains(tag, "omitempty") && val.IsZero()) {
 				w.Append([]string{tag[0], fmt.Sprint(val)})
@@ -201,6 +221,120 @@ func (t *Table) printCore(writer io.Writer, auto bool) error {
 	return nil
 }
 
+// printDelimited writes a header row followed by one row per object, using the same columns as the human-readable list.
+func (t *Table) printDelimited(writer io.Writer) error {
+	w := csv.NewWriter(writer)
+	if t.format == TSV {
+		w.Comma = '\t'
+	}
+
+	if t.isMap() {
+		m := t.objects[0].(map[string]string)
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		values := make([]string, len(keys))
+		for i, k := range keys {
+			values[i] = m[k]
+		}
+
+		if err := w.WriteAll([][]string{keys, values}); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	columns := t.columns
+	if len(t.objects) > 0 {
+		columns = t.objects[0]
+	}
+	if columns == nil {
+		return nil
+	}
+
+	var header []string
+	for i := 0; i < reflect.TypeOf(columns).Elem().NumField(); i++ {
+		if tag := delimitedTag(reflect.TypeOf(columns).Elem().Field(i)); !slices.Contains(tag, "-") {
+			header = append(header, tag[0])
+		}
+	}
+	if err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, object := range t.objects {
+		var row []string
+		for i := 0; i < reflect.TypeOf(object).Elem().NumField(); i++ {
+			if tag := delimitedTag(reflect.TypeOf(object).Elem().Field(i)); !slices.Contains(tag, "-") {
+				val := reflect.ValueOf(object).Elem().Field(i)
+				row = append(row, getDelimitedValue(val))
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// delimitedTag returns the human tag of a field, or its serialized tag for structs which are only printed in serialized formats.
+func delimitedTag(field reflect.StructField) []string {
+	tag, ok := field.Tag.Lookup(Human.String())
+	if !ok {
+		tag, ok = field.Tag.Lookup("serialized")
+	}
+	if !ok {
+		tag = field.Name
+	}
+	return strings.Split(tag, ",")
+}
+
+// getDelimitedValue formats a value as a single cell. Lists are joined by commas, and maps and structs are written as JSON.
+func getDelimitedValue(val reflect.Value) string {
+	for val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return ""
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Slice, reflect.Array:
+		elements := make([]string, val.Len())
+		for i := range elements {
+			elements[i] = getDelimitedValue(val.Index(i))
+		}
+		return strings.Join(elements, ",")
+	case reflect.Map, reflect.Struct:
+		out, err := json.Marshal(val.Interface())
+		if err != nil {
+			return fmt.Sprint(val)
+		}
+		return string(out)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// writeNDJSON writes each object as compact JSON on its own line.
+func writeNDJSON(writer io.Writer, objects []any) error {
+	for _, object := range objects {
+		out, err := json.Marshal(object)
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(append(out, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getValueAsString(val reflect.Value, tag []string) string {
 	if slices.Contains(tag, "Current") {
 		if val.Bool() {
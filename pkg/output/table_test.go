@@ -3,6 +3,8 @@ package output
 import (
 	"bytes"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -42,6 +44,17 @@ func TestTable(t *testing.T) {
 			"name: lkc-123456",
 			"description: Example Cluster",
 		},
+		CSV.String(): {
+			"Current,ID,Name,Description",
+			"true,1,lkc-123456,Example Cluster",
+		},
+		TSV.String(): {
+			"Current\tID\tName\tDescription",
+			"true\t1\tlkc-123456\tExample Cluster",
+		},
+		NDJSON.String(): {
+			`{"is_current":true,"id":1,"name":"lkc-123456","description":"Example Cluster"}`,
+		},
 	}
 
 	for format, expected := range tests {
@@ -227,6 +240,13 @@ func TestTable_Map(t *testing.T) {
 		YAML.String(): {
 			"A: apple",
 		},
+		CSV.String(): {
+			"A",
+			"apple",
+		},
+		NDJSON.String(): {
+			`{"A":"apple"}`,
+		},
 	}
 
 	for format, expected := range tests {
@@ -308,6 +328,20 @@ func TestList(t *testing.T) {
 			"  name: lkc-222222",
 			"  description: Cluster 2",
 		},
+		CSV.String(): {
+			"Current,ID,Name,Description",
+			"true,1,lkc-111111,Cluster 1",
+			"false,2,lkc-222222,Cluster 2",
+		},
+		TSV.String(): {
+			"Current\tID\tName\tDescription",
+			"true\t1\tlkc-111111\tCluster 1",
+			"false\t2\tlkc-222222\tCluster 2",
+		},
+		NDJSON.String(): {
+			`{"is_current":true,"id":1,"name":"lkc-111111","description":"Cluster 1"}`,
+			`{"is_current":false,"id":2,"name":"lkc-222222","description":"Cluster 2"}`,
+		},
 	}
 
 	// Order is intentionally reversed to test sorting
@@ -349,6 +383,52 @@ func TestList_Empty(t *testing.T) {
 	}
 }
 
+func TestList_EmptyColumns(t *testing.T) {
+	tests := map[string]string{
+		Human.String(): "None found.\n",
+		CSV.String():   "Current,ID,Name,Description\n",
+		TSV.String():   "Current\tID\tName\tDescription\n",
+	}
+
+	for format, expected := range tests {
+		buf := new(bytes.Buffer)
+		cmd := &cobra.Command{}
+		cmd.Flags().String("output", format, "")
+		cmd.SetOut(buf)
+
+		list := NewList(cmd)
+		list.Columns(new(out))
+		require.NoError(t, list.Print())
+		require.Equal(t, expected, buf.String(), format)
+	}
+}
+
+func TestList_DelimitedSerializedOnly(t *testing.T) {
+	type serializedOut struct {
+		Id     string            `serialized:"id"`
+		Roles  []string          `serialized:"roles"`
+		Labels map[string]string `serialized:"labels"`
+		Hidden string            `serialized:"-"`
+	}
+
+	tests := map[string]string{
+		CSV.String(): "id,roles,labels\na,\"x,y\",\"{\"\"env\"\":\"\"dev\"\"}\"\n",
+		TSV.String(): "id\troles\tlabels\na\tx,y\t\"{\"\"env\"\":\"\"dev\"\"}\"\n",
+	}
+
+	for format, expected := range tests {
+		buf := new(bytes.Buffer)
+		cmd := &cobra.Command{}
+		cmd.Flags().String("output", format, "")
+		cmd.SetOut(buf)
+
+		list := NewList(cmd)
+		list.Add(&serializedOut{Id: "a", Roles: []string{"x", "y"}, Labels: map[string]string{"env": "dev"}, Hidden: "h"})
+		require.NoError(t, list.Print())
+		require.Equal(t, expected, buf.String(), format)
+	}
+}
+
 func testList(t *testing.T, format string, objects []any, expected []string) {
 	buf := new(bytes.Buffer)
 	cmd := &cobra.Command{}
@@ -365,3 +445,92 @@ func testList(t *testing.T, format string, objects []any, expected []string) {
 
 	require.Equal(t, strings.Join(expected, "\n")+"\n", buf.String(), format)
 }
+
+func TestTable_Template(t *testing.T) {
+	tests := map[string]string{
+		GoTemplate.String() + "{{.name}}":                "lkc-123456",
+		JSONPath.String() + "{.id}":                      "1",
+		JSONPath.String() + ".description":               "Example Cluster",
+		JSONPath.String() + `{.name}{"\t"}{.is_current}`: "lkc-123456\ttrue",
+	}
+
+	for format, expected := range tests {
+		buf := new(bytes.Buffer)
+		cmd := &cobra.Command{}
+		cmd.Flags().String("output", format, "")
+		cmd.SetOut(buf)
+
+		table := NewTable(cmd)
+		table.Add(&out{
+			IsCurrent:   true,
+			Id:          1,
+			Name:        "lkc-123456",
+			Description: "Example Cluster",
+		})
+
+		err := table.Print()
+		require.NoError(t, err)
+
+		require.Equal(t, expected, buf.String(), format)
+	}
+}
+
+func TestTable_TemplateFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "template.tmpl")
+	require.NoError(t, os.WriteFile(file, []byte("{{.id}}: {{.name}}\n"), 0600))
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", GoTemplateFile.String()+file, "")
+	cmd.SetOut(buf)
+
+	table := NewTable(cmd)
+	table.Add(&out{Id: 1, Name: "lkc-123456"})
+
+	err := table.Print()
+	require.NoError(t, err)
+
+	require.Equal(t, "1: lkc-123456\n", buf.String())
+}
+
+func TestTable_InvalidTemplate(t *testing.T) {
+	for _, format := range []string{GoTemplate.String() + "{{.name", JSONPath.String() + "{.name", GoTemplate.String(), GoTemplate.String() + "{{.missing}}"} {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("output", format, "")
+		cmd.SetOut(new(bytes.Buffer))
+
+		table := NewTable(cmd)
+		table.Add(&out{Id: 1, Name: "lkc-123456"})
+
+		require.Error(t, table.Print(), format)
+	}
+}
+
+func TestList_Template(t *testing.T) {
+	objects := []any{
+		&out{Id: 2, Name: "lkc-222222"},
+		&out{Id: 1, Name: "lkc-111111"},
+	}
+
+	tests := map[string][]string{
+		GoTemplate.String() + `{{range .}}{{.name}}{{"\n"}}{{end}}`: {"lkc-111111", "lkc-222222", ""},
+		JSONPath.String() + `{range [*]}{.id}{"\n"}{end}`:           {"1", "2", ""},
+	}
+
+	for format, expected := range tests {
+		buf := new(bytes.Buffer)
+		cmd := &cobra.Command{}
+		cmd.Flags().String("output", format, "")
+		cmd.SetOut(buf)
+
+		list := NewList(cmd)
+		for _, object := range objects {
+			list.Add(object)
+		}
+
+		err := list.Print()
+		require.NoError(t, err)
+
+		require.Equal(t, strings.Join(expected, "\n"), buf.String(), format)
+	}
+}
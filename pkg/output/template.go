@@ -0,0 +1,94 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/confluentinc/cli/v3/pkg/output/jsonpath"
+)
+
+// getTemplate returns the template text which follows the format prefix in the output flag, if any.
+func getTemplate(cmd *cobra.Command) string {
+	format := GetFormat(cmd)
+	if !format.IsTemplate() {
+		return ""
+	}
+
+	value, _ := cmd.Flags().GetString(FlagName)
+	return strings.TrimPrefix(value, format.String())
+}
+
+// writeTemplate evaluates a Go template or JSONPath expression against the JSON representation of v.
+func writeTemplate(writer io.Writer, format Format, text string, v any) error {
+	if format == GoTemplateFile {
+		contents, err := os.ReadFile(text)
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+		text = string(contents)
+	}
+
+	if text == "" {
+		return fmt.Errorf(`template for output format "%s" must not be empty`, format)
+	}
+
+	data, err := toJSONObject(v)
+	if err != nil {
+		return err
+	}
+
+	if format == JSONPath {
+		j := jsonpath.New(FlagName).AllowMissingKeys(true)
+		if err := j.Parse(relaxedJSONPathExpression(text)); err != nil {
+			return fmt.Errorf("failed to parse JSONPath expression: %w", err)
+		}
+		return j.Execute(writer, data)
+	}
+
+	// Referring to a missing field is an error rather than printing "<no value>", since it is most likely a typo.
+	tmpl, err := template.New(FlagName).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+	if err := tmpl.Execute(writer, data); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return nil
+}
+
+// toJSONObject converts v into the generic maps and slices it would decode to if printed as JSON,
+// so templates refer to fields by their serialized names.
+func toJSONObject(v any) (any, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(out))
+	decoder.UseNumber()
+
+	var data any
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// relaxedJSONPathExpression allows expressions such as ".id" or "id" in place of "{.id}", as kubectl does.
+func relaxedJSONPathExpression(text string) string {
+	if strings.Contains(text, "{") {
+		return text
+	}
+
+	if !strings.HasPrefix(text, ".") {
+		text = "." + text
+	}
+	return fmt.Sprintf("{%s}", text)
+}
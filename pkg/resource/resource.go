@@ -35,6 +35,7 @@ const (
 	MirrorTopic                 = "mirror topic"
 	Organization                = "organization"
 	ProviderShare               = "provider share"
+	RoleBinding                 = "role binding"
 	Pipeline                    = "pipeline"
 	SchemaExporter              = "schema exporter"
 	SchemaRegistryCluster       = "Schema Registry cluster"
@@ -67,6 +67,11 @@ func (c *Client) UpdateTopLevelMode(res srsdk.ModeUpdateRequest) (srsdk.ModeUpda
 	return res, err
 }
 
+func (c *Client) GetMode(subject string) (srsdk.Mode, error) {
+	res, _, err := c.DefaultApi.GetMode(c.context, subject, nil)
+	return res, err
+}
+
 func (c *Client) UpdateMode(subject string, req srsdk.ModeUpdateRequest) (srsdk.ModeUpdateRequest, error) {
 	res, _, err := c.DefaultApi.UpdateMode(c.context, subject, req)
 	return res, err
@@ -0,0 +1,24 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+)
+
+// IsNotFoundError returns whether Schema Registry responded that a subject, version, schema, or subject-level setting
+// does not exist. These responses have error codes 40401 through 40409.
+func IsNotFoundError(err error) bool {
+	var openAPIErr srsdk.GenericOpenAPIError
+	if !errors.As(err, &openAPIErr) {
+		return false
+	}
+
+	var errorMessage srsdk.ErrorMessage
+	if err := json.Unmarshal(openAPIErr.Body(), &errorMessage); err != nil {
+		return false
+	}
+	return errorMessage.ErrorCode/100 == http.StatusNotFound
+}
@@ -0,0 +1,83 @@
+package schemaregistry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	srsdk "github.com/confluentinc/schema-registry-sdk-go"
+)
+
+const schemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+// LookUpSchemaUnderSubject returns the version of a subject which has the given schema. The SDK discards the response
+// of this endpoint, so the request is sent directly, with the configuration and credentials of the client.
+func (c *Client) LookUpSchemaUnderSubject(subject string, req srsdk.RegisterSchemaRequest, normalize bool) (srsdk.Schema, error) {
+	cfg := c.GetConfig()
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return srsdk.Schema{}, err
+	}
+
+	path := fmt.Sprintf("%s/subjects/%s", cfg.BasePath, url.PathEscape(subject))
+	if normalize {
+		path += "?normalize=true"
+	}
+
+	httpReq, err := http.NewRequestWithContext(c.context, http.MethodPost, path, bytes.NewReader(body))
+	if err != nil {
+		return srsdk.Schema{}, err
+	}
+
+	for key, value := range cfg.DefaultHeader {
+		httpReq.Header.Set(key, value)
+	}
+	httpReq.Header.Set("Content-Type", schemaRegistryContentType)
+	httpReq.Header.Set("Accept", schemaRegistryContentType)
+	if cfg.UserAgent != "" {
+		httpReq.Header.Set("User-Agent", cfg.UserAgent)
+	}
+	if auth, ok := c.context.Value(srsdk.ContextBasicAuth).(srsdk.BasicAuth); ok {
+		httpReq.SetBasicAuth(auth.UserName, auth.Password)
+	}
+	if token, ok := c.context.Value(srsdk.ContextAccessToken).(string); ok {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	res, err := httpClient.Do(httpReq)
+	if err != nil {
+		return srsdk.Schema{}, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return srsdk.Schema{}, err
+	}
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		formattedErr := &struct {
+			ErrorCode int    `json:"error_code"`
+			Message   string `json:"message"`
+		}{}
+		if err := json.Unmarshal(data, formattedErr); err == nil && formattedErr.Message != "" {
+			return srsdk.Schema{}, fmt.Errorf("failed to look up schema under subject \"%s\": %s", subject, formattedErr.Message)
+		}
+		return srsdk.Schema{}, fmt.Errorf("failed to look up schema under subject \"%s\": %s", subject, res.Status)
+	}
+
+	var schema srsdk.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return srsdk.Schema{}, err
+	}
+	return schema, nil
+}
@@ -15,8 +15,11 @@ const (
 	MetadataKeyLength             = "_metadata.symmetric_key.0.length"
 	MetadataDEKSalt               = "_metadata.symmetric_key.0.salt"
 	MetadataMEKSalt               = "_metadata.master_key.0.salt"
+	MetadataMEKBackend            = "_metadata.master_key.0.backend"
+	MetadataMEKBackendLocation    = "_metadata.master_key.0.backend_location"
 	MetadataKeyIterations         = "_metadata.symmetric_key.0.iterations"
 	MetadataDataKey               = "_metadata.symmetric_key.0.enc"
+	MetadataKeyAlgorithm          = "_metadata.symmetric_key.0.algorithm"
 	MetadataKeyDefaultLengthBytes = 32
 	MetadataKeyDefaultIterations  = 10000
 	MetadataPrefix                = "_metadata"
@@ -2,7 +2,6 @@ package secret
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -22,7 +21,7 @@ import (
 // Passwords in property file are encrypted and stored in security config file.
 
 type PasswordProtection interface {
-	CreateMasterKey(passphrase, localSecureConfigPath string) (string, error)
+	CreateMasterKey(passphrase, localSecureConfigPath string, backend SecretBackend) (string, error)
 	EncryptConfigFileSecrets(configFilePath, localSecureConfigPath, remoteSecureConfigPath, encryptConfigKeys string) error
 	DecryptConfigFileSecrets(configFilePath, localSecureConfigPath, outputFilePath, configs string) error
 	AddEncryptedPasswords(configFilePath, localSecureConfigPath, remoteSecureConfigPath, newConfigs string) error
@@ -30,6 +29,7 @@ type PasswordProtection interface {
 	RemoveEncryptedPasswords(configFilePath, localSecureConfigPath, removeConfigs string) error
 	RotateMasterKey(oldPassphrase, newPassphrase, localSecureConfigPath string) (string, error)
 	RotateDataKey(passphrase, localSecureConfigPath string) error
+	MigrateSecrets(localSecureConfigPath string) (int, error)
 }
 
 type PasswordProtectionSuite struct {
@@ -42,9 +42,9 @@ func NewPasswordProtectionPlugin() *PasswordProtectionSuite {
 	return &PasswordProtectionSuite{Clock: clockwork.NewRealClock()}
 }
 
-// This function generates a new data key for encryption/decryption of secrets. The DEK is wrapped using the master key and saved in the secrets file
-// along with other metadata.
-func (c *PasswordProtectionSuite) CreateMasterKey(passphrase, localSecureConfigPath string) (string, error) {
+// This function generates a new master key from the passphrase and saves it to the secret backend. The backend is recorded in the secrets file
+// along with other metadata, so that later commands load the master key from the same backend.
+func (c *PasswordProtectionSuite) CreateMasterKey(passphrase, localSecureConfigPath string, backend SecretBackend) (string, error) {
 	passphrase = strings.TrimSuffix(passphrase, "\n")
 	if strings.TrimSpace(passphrase) == "" {
 		return "", fmt.Errorf(errors.EmptyPassphraseErrorMsg)
@@ -78,10 +78,17 @@ func (c *PasswordProtectionSuite) CreateMasterKey(passphrase, localSecureConfigP
 		return "", err
 	}
 
-	// save the master key salt
+	if err := backend.StoreMasterKey(newMasterKey); err != nil {
+		return "", err
+	}
+
+	// save the master key salt and backend
 	if _, _, err := secureConfigProps.Set(MetadataMEKSalt, salt); err != nil {
 		return "", err
 	}
+	if err := setSecretBackend(secureConfigProps, backend); err != nil {
+		return "", err
+	}
 
 	now := c.Clock.Now()
 	if _, _, err := secureConfigProps.Set(MetadataKeyTimestamp, now.String()); err != nil {
@@ -178,9 +185,15 @@ func (c *PasswordProtectionSuite) DecryptConfigFileSecrets(configFilePath, local
 		return err
 	}
 
+	// Load MEK
+	masterKey, err := c.loadMasterKey(localSecureConfigPath)
+	if err != nil {
+		return err
+	}
+
 	engine := NewEncryptionEngine(cipherSuite)
 	// Unwrap DEK with MEK
-	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine)
+	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine, masterKey)
 	if err != nil {
 		log.CliLogger.Debug(err)
 		return fmt.Errorf(errors.UnwrapDataKeyErrorMsg)
@@ -225,7 +238,7 @@ func (c *PasswordProtectionSuite) RotateDataKey(masterPassphrase, localSecureCon
 	}
 
 	// Load MEK
-	masterKey, err := c.loadMasterKey()
+	masterKey, err := c.loadMasterKey(localSecureConfigPath)
 	if err != nil {
 		return err
 	}
@@ -249,7 +262,7 @@ func (c *PasswordProtectionSuite) RotateDataKey(masterPassphrase, localSecureCon
 	}
 
 	// Unwrap old DEK using the MEK
-	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine)
+	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine, masterKey)
 	if err != nil {
 		log.CliLogger.Debug(err)
 		return fmt.Errorf(errors.UnwrapDataKeyErrorMsg)
@@ -262,46 +275,81 @@ func (c *PasswordProtectionSuite) RotateDataKey(masterPassphrase, localSecureCon
 	}
 
 	// Re-encrypt the ciphers with new DEK
-	for key, value := range secureConfigProps.Map() {
-		if c.isCipher(value) && !strings.HasPrefix(key, MetadataPrefix) {
-			data, iv, algo := ParseCipherValue(value)
-			plainSecret, err := engine.Decrypt(data, iv, algo, dataKey)
-			if err != nil {
-				return err
-			}
-			cipher, iv, err := engine.Encrypt(plainSecret, newDataKey)
-			if err != nil {
-				return err
-			}
-			formattedCipher := c.formatCipherValue(cipher, iv)
-			if _, _, err := secureConfigProps.Set(key, formattedCipher); err != nil {
-				return err
-			}
-		}
-	}
-
-	// Wrap new DEK with MEK
-	wrappedNewDK, err := c.wrapDataKey(engine, newDataKey, masterKey)
-	if err != nil {
+	if _, err := c.reencryptCiphers(secureConfigProps, engine, dataKey, newDataKey); err != nil {
 		return err
 	}
 
 	// Save new DEK and re-encrypted ciphers.
-	now := c.Clock.Now()
-	if _, _, err := secureConfigProps.Set(MetadataKeyTimestamp, now.String()); err != nil {
+	if err := c.setDataKey(secureConfigProps, engine, newDataKey, salt, masterKey); err != nil {
 		return err
 	}
-	if _, _, err := secureConfigProps.Set(MetadataDataKey, wrappedNewDK); err != nil {
-		return err
+	return WritePropertiesFile(localSecureConfigPath, secureConfigProps, true)
+}
+
+// This function re-encrypts the secrets file with AES/GCM/NoPadding, which unlike the legacy AES/CBC/PKCS5Padding algorithm also protects the
+// integrity of the secrets. The legacy ciphers are decrypted with the old data key, and every cipher is re-encrypted with a new data key.
+// It returns the number of ciphers, including the data key, which used the legacy algorithm.
+func (c *PasswordProtectionSuite) MigrateSecrets(localSecureConfigPath string) (int, error) {
+	cipherSuite, err := c.loadCipherSuiteFromLocalFile(localSecureConfigPath)
+	if err != nil {
+		return 0, err
 	}
-	if _, _, err := secureConfigProps.Set(MetadataDEKSalt, salt); err != nil {
-		return err
+	if cipherSuite.EncryptedDataKey == "" {
+		return 0, fmt.Errorf(`no data key found in secrets file "%s"`, localSecureConfigPath)
+	}
+
+	// Load MEK
+	masterKey, err := c.loadMasterKey(localSecureConfigPath)
+	if err != nil {
+		return 0, err
+	}
+
+	secureConfigProps, err := utils.LoadPropertiesFile(localSecureConfigPath)
+	if err != nil {
+		return 0, err
+	}
+	secureConfigProps.DisableExpansion = true
+
+	engine := NewEncryptionEngine(cipherSuite)
+
+	// Unwrap old DEK using the MEK
+	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine, masterKey)
+	if err != nil {
+		log.CliLogger.Debug(err)
+		return 0, fmt.Errorf(errors.UnwrapDataKeyErrorMsg)
+	}
+
+	legacyCount := c.countLegacyCiphers(secureConfigProps)
+	if legacyCount == 0 {
+		// Only record the algorithm of a file which was already encrypted with it
+		if cipherSuite.EncryptionAlgo == AesGcm {
+			return 0, nil
+		}
+		if _, _, err := secureConfigProps.Set(MetadataKeyAlgorithm, AesGcm); err != nil {
+			return 0, err
+		}
+		return 0, WritePropertiesFile(localSecureConfigPath, secureConfigProps, true)
+	}
+
+	// Generate a new DEK
+	newDataKey, salt, err := engine.GenerateRandomDataKey(MetadataKeyDefaultLengthBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	// Re-encrypt the ciphers with new DEK
+	if _, err := c.reencryptCiphers(secureConfigProps, engine, dataKey, newDataKey); err != nil {
+		return 0, err
+	}
+
+	if err := c.setDataKey(secureConfigProps, engine, newDataKey, salt, masterKey); err != nil {
+		return 0, err
 	}
 	if err := WritePropertiesFile(localSecureConfigPath, secureConfigProps, true); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return legacyCount, nil
 }
 
 // This function is used to change the master key. It wraps the data key with newly set master key.
@@ -322,7 +370,7 @@ func (c *PasswordProtectionSuite) RotateMasterKey(oldPassphrase, newPassphrase,
 	}
 
 	// Load MEK
-	masterKey, err := c.loadMasterKey()
+	masterKey, err := c.loadMasterKey(localSecureConfigPath)
 	if err != nil {
 		return "", err
 	}
@@ -341,7 +389,7 @@ func (c *PasswordProtectionSuite) RotateMasterKey(oldPassphrase, newPassphrase,
 	}
 
 	// Unwrap DEK using the MEK
-	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine)
+	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine, masterKey)
 	if err != nil {
 		log.CliLogger.Debug(err)
 		return "", fmt.Errorf(errors.UnwrapDataKeyErrorMsg)
@@ -364,6 +412,11 @@ func (c *PasswordProtectionSuite) RotateMasterKey(oldPassphrase, newPassphrase,
 		return "", err
 	}
 
+	backend, err := loadSecretBackendFromSecureProps(secureConfigProps)
+	if err != nil {
+		return "", err
+	}
+
 	// Save DEK
 	now := c.Clock.Now()
 	if _, _, err := secureConfigProps.Set(MetadataKeyTimestamp, now.String()); err != nil {
@@ -378,7 +431,15 @@ func (c *PasswordProtectionSuite) RotateMasterKey(oldPassphrase, newPassphrase,
 		return "", err
 	}
 
+	if err := backend.StoreMasterKey(newMasterKey); err != nil {
+		return "", err
+	}
+
+	// Restore the old master key if the secrets file, whose data key is still wrapped with it, cannot be written
 	if err := WritePropertiesFile(localSecureConfigPath, secureConfigProps, true); err != nil {
+		if restoreErr := backend.StoreMasterKey(masterKey); restoreErr != nil {
+			return "", fmt.Errorf("%w: failed to restore the previous master key in the %s backend: %v", err, backend.Name(), restoreErr)
+		}
 		return "", err
 	}
 
@@ -506,6 +567,67 @@ func (c *PasswordProtectionSuite) wrapDataKey(engine EncryptionEngine, dataKey [
 	return c.formatCipherValue(wrappedDataKey, iv), nil
 }
 
+// reencryptCiphers decrypts every cipher in the secrets file with the old data key, whatever its algorithm, and encrypts it again with
+// the new data key. It returns the number of re-encrypted ciphers.
+func (c *PasswordProtectionSuite) reencryptCiphers(secureConfigProps *properties.Properties, engine EncryptionEngine, dataKey, newDataKey []byte) (int, error) {
+	count := 0
+	for key, value := range secureConfigProps.Map() {
+		if c.isCipher(value) && !strings.HasPrefix(key, MetadataPrefix) {
+			data, iv, algo := ParseCipherValue(value)
+			plainSecret, err := engine.Decrypt(data, iv, algo, dataKey)
+			if err != nil {
+				return 0, err
+			}
+			cipher, iv, err := engine.Encrypt(plainSecret, newDataKey)
+			if err != nil {
+				return 0, err
+			}
+			formattedCipher := c.formatCipherValue(cipher, iv)
+			if _, _, err := secureConfigProps.Set(key, formattedCipher); err != nil {
+				return 0, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// setDataKey wraps a new data key with the master key and saves it, along with its metadata, in the secrets file properties.
+func (c *PasswordProtectionSuite) setDataKey(secureConfigProps *properties.Properties, engine EncryptionEngine, dataKey []byte, salt, masterKey string) error {
+	// Wrap new DEK with MEK
+	wrappedDataKey, err := c.wrapDataKey(engine, dataKey, masterKey)
+	if err != nil {
+		return err
+	}
+
+	now := c.Clock.Now()
+	if _, _, err := secureConfigProps.Set(MetadataKeyTimestamp, now.String()); err != nil {
+		return err
+	}
+	if _, _, err := secureConfigProps.Set(MetadataDataKey, wrappedDataKey); err != nil {
+		return err
+	}
+	if _, _, err := secureConfigProps.Set(MetadataDEKSalt, salt); err != nil {
+		return err
+	}
+	_, _, err = secureConfigProps.Set(MetadataKeyAlgorithm, AesGcm)
+	return err
+}
+
+// countLegacyCiphers returns the number of ciphers in the secrets file, including the data key, which use AES/CBC/PKCS5Padding.
+func (c *PasswordProtectionSuite) countLegacyCiphers(secureConfigProps *properties.Properties) int {
+	count := 0
+	for key, value := range secureConfigProps.Map() {
+		if !c.isCipher(value) || (strings.HasPrefix(key, MetadataPrefix) && key != MetadataDataKey) {
+			continue
+		}
+		if _, _, algo := ParseCipherValue(value); algo == AesCbc {
+			count++
+		}
+	}
+	return count
+}
+
 func (c *PasswordProtectionSuite) loadCipherSuiteFromLocalFile(localSecureConfigPath string) (*Cipher, error) {
 	secureConfigProps, err := utils.LoadPropertiesFile(localSecureConfigPath)
 	if err != nil {
@@ -524,6 +646,8 @@ func (c *PasswordProtectionSuite) loadCipherSuiteFromSecureProps(secureConfigPro
 	cipher.SaltDEK = matchProps.GetString(MetadataDEKSalt, "")
 	cipher.SaltMEK = matchProps.GetString(MetadataMEKSalt, "")
 	cipher.EncryptedDataKey = matchProps.GetString(MetadataDataKey, "")
+	// Files written before the algorithm was recorded may contain ciphers of the legacy algorithm
+	cipher.EncryptionAlgo = matchProps.GetString(MetadataKeyAlgorithm, "")
 	return cipher, nil
 }
 
@@ -539,11 +663,7 @@ func (c *PasswordProtectionSuite) isCipher(config string) bool {
 	return cipherRegex.MatchString(config)
 }
 
-func (c *PasswordProtectionSuite) unwrapDataKey(key string, engine EncryptionEngine) ([]byte, error) {
-	masterKey, err := c.loadMasterKey()
-	if err != nil {
-		return []byte{}, err
-	}
+func (c *PasswordProtectionSuite) unwrapDataKey(key string, engine EncryptionEngine, masterKey string) ([]byte, error) {
 	data, iv, algo := ParseCipherValue(key)
 	return engine.UnwrapDataKey(data, iv, algo, masterKey)
 }
@@ -592,21 +712,24 @@ func (c *PasswordProtectionSuite) fetchSecureConfigProps(localSecureConfigPath,
 	if _, _, err := secureConfigProps.Set(MetadataDataKey, cipherSuites.EncryptedDataKey); err != nil {
 		return nil, nil, err
 	}
+	if _, _, err := secureConfigProps.Set(MetadataKeyAlgorithm, cipherSuites.EncryptionAlgo); err != nil {
+		return nil, nil, err
+	}
 	return secureConfigProps, cipherSuites, nil
 }
 
-func (c *PasswordProtectionSuite) loadMasterKey() (string, error) {
-	// Check if master key is created and set in the environment variable
-	masterKey, found := os.LookupEnv(ConfluentKeyEnvVar)
-	if !found {
-		return "", errors.NewErrorWithSuggestions(fmt.Sprintf(errors.MasterKeyNotExportedErrorMsg, ConfluentKeyEnvVar), fmt.Sprintf(errors.MasterKeyNotExportedSuggestions, ConfluentKeyEnvVar))
+func (c *PasswordProtectionSuite) loadMasterKey(localSecureConfigPath string) (string, error) {
+	// Load the master key from the backend recorded in the secrets file, which defaults to the environment variable
+	backend, err := LoadSecretBackend(localSecureConfigPath)
+	if err != nil {
+		return "", err
 	}
-	return masterKey, nil
+	return backend.LoadMasterKey()
 }
 
 func (c *PasswordProtectionSuite) encryptConfigValues(matchProps *properties.Properties, localSecureConfigPath, configFilePath, remoteConfigFilePath string) error {
 	// Load master Key
-	masterKey, err := c.loadMasterKey()
+	masterKey, err := c.loadMasterKey(localSecureConfigPath)
 	if err != nil {
 		return err
 	}
@@ -619,7 +742,7 @@ func (c *PasswordProtectionSuite) encryptConfigValues(matchProps *properties.Pro
 
 	// Unwrap DEK
 	engine := NewEncryptionEngine(cipherSuite)
-	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine)
+	dataKey, err := c.unwrapDataKey(cipherSuite.EncryptedDataKey, engine, masterKey)
 	if err != nil {
 		log.CliLogger.Debug(err)
 		return fmt.Errorf(errors.UnwrapDataKeyErrorMsg)
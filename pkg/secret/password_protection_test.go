@@ -1,8 +1,12 @@
 package secret
 
 import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base32"
+	"encoding/base64"
 	"fmt"
 	"os"
 	"strings"
@@ -102,14 +106,14 @@ func TestPasswordProtectionSuite_CreateMasterKey(t *testing.T) {
 
 			plugin := NewPasswordProtectionPlugin()
 
-			key, err := plugin.CreateMasterKey(test.args.masterKeyPassphrase, test.args.localSecureConfigPath)
+			key, err := plugin.CreateMasterKey(test.args.masterKeyPassphrase, test.args.localSecureConfigPath, &envSecretBackend{envVar: ConfluentKeyEnvVar})
 			checkError(err, test.wantErr, test.wantErrMsg, req)
 			if !test.wantErr {
 				req.Len(key, 44)
 			}
 
 			if test.args.validateDiffKey {
-				newKey, err := plugin.CreateMasterKey(test.args.masterKeyPassphrase, test.args.localSecureConfigPath)
+				newKey, err := plugin.CreateMasterKey(test.args.masterKeyPassphrase, test.args.localSecureConfigPath, &envSecretBackend{envVar: ConfluentKeyEnvVar})
 				checkError(err, test.wantErr, test.wantErrMsg, req)
 				req.Len(newKey, 44)
 				req.NotEqual(key, newKey)
@@ -1336,7 +1340,7 @@ func TestPasswordProtectionSuite_RotateMasterKey(t *testing.T) {
 }
 
 func createMasterKey(passphrase, localSecretsFile string, plugin *PasswordProtectionSuite) error {
-	key, err := plugin.CreateMasterKey(passphrase, localSecretsFile)
+	key, err := plugin.CreateMasterKey(passphrase, localSecretsFile, &envSecretBackend{envVar: ConfluentKeyEnvVar})
 	if err != nil {
 		return err
 	}
@@ -1484,3 +1488,104 @@ func checkErrorAndSuggestions(err error, wantErr bool, wantErrMsg, wantSuggestio
 		req.NoError(err)
 	}
 }
+
+func TestPasswordProtectionSuite_MigrateSecrets(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	configFilePath := dir + "/config.properties"
+	localSecureConfigPath := dir + "/secureConfig.properties"
+	outputConfigPath := dir + "/output.properties"
+	contents := "ssl.keystore.password = password\nssl.truststore.password = other-password\n"
+
+	plugin, err := setUpDir("abc123", dir, configFilePath, localSecureConfigPath, contents)
+	defer os.Unsetenv(ConfluentKeyEnvVar)
+	req.NoError(err)
+	req.NoError(plugin.EncryptConfigFileSecrets(configFilePath, localSecureConfigPath, localSecureConfigPath, ""))
+
+	req.NoError(downgradeToCbc(plugin, localSecureConfigPath))
+	req.NoError(validateUsingDecryption(configFilePath, localSecureConfigPath, outputConfigPath, contents, plugin))
+
+	count, err := plugin.MigrateSecrets(localSecureConfigPath)
+	req.NoError(err)
+	req.Equal(3, count)
+
+	secureConfigProps, err := utils.LoadPropertiesFile(localSecureConfigPath)
+	req.NoError(err)
+	req.Equal(AesGcm, secureConfigProps.GetString(MetadataKeyAlgorithm, ""))
+	req.Zero(plugin.countLegacyCiphers(secureConfigProps))
+	req.NoError(validateUsingDecryption(configFilePath, localSecureConfigPath, outputConfigPath, contents, plugin))
+
+	count, err = plugin.MigrateSecrets(localSecureConfigPath)
+	req.NoError(err)
+	req.Zero(count)
+}
+
+// downgradeToCbc re-encrypts the data key and secrets of a secrets file with the legacy AES/CBC/PKCS5Padding algorithm.
+func downgradeToCbc(plugin *PasswordProtectionSuite, localSecureConfigPath string) error {
+	secureConfigProps, err := utils.LoadPropertiesFile(localSecureConfigPath)
+	if err != nil {
+		return err
+	}
+	secureConfigProps.DisableExpansion = true
+
+	cipherSuite, err := plugin.loadCipherSuiteFromSecureProps(secureConfigProps)
+	if err != nil {
+		return err
+	}
+	engine := NewEncryptionEngine(cipherSuite)
+
+	masterKey := os.Getenv(ConfluentKeyEnvVar)
+	dataKey, err := plugin.unwrapDataKey(cipherSuite.EncryptedDataKey, engine, masterKey)
+	if err != nil {
+		return err
+	}
+	masterKeyBytes, err := base64.StdEncoding.DecodeString(masterKey)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range secureConfigProps.Map() {
+		if !plugin.isCipher(value) {
+			continue
+		}
+		data, iv, algo := ParseCipherValue(value)
+		if key == MetadataDataKey {
+			value, err = encryptCbc(base64.StdEncoding.EncodeToString(dataKey), masterKeyBytes)
+		} else {
+			var plainSecret string
+			if plainSecret, err = engine.Decrypt(data, iv, algo, dataKey); err == nil {
+				value, err = encryptCbc(plainSecret, dataKey)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if _, _, err := secureConfigProps.Set(key, value); err != nil {
+			return err
+		}
+	}
+	secureConfigProps.Delete(MetadataKeyAlgorithm)
+
+	return WritePropertiesFile(localSecureConfigPath, secureConfigProps, true)
+}
+
+func encryptCbc(plainText string, key []byte) (string, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", err
+	}
+
+	padding := aes.BlockSize - len(plainText)%aes.BlockSize
+	content := append([]byte(plainText), bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return "", err
+	}
+
+	encrypted := make([]byte, len(content))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, content)
+
+	return fmt.Sprintf("ENC[%s,data:%s,iv:%s,type:str]", AesCbc, base64.StdEncoding.EncodeToString(encrypted), base64.StdEncoding.EncodeToString(iv)), nil
+}
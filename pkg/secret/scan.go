@@ -0,0 +1,131 @@
+package secret
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+var (
+	scannedPropertiesExtensions = []string{".properties"}
+	scannedJAASExtensions       = []string{".conf", ".jaas"}
+
+	jaasSectionRegex = regexp.MustCompile(`(?ms)^\s*([\w.-]+)\s*\{(.*?)^\s*\}\s*;`)
+	passwordKeyRegex = regexp.MustCompile(`(?i)password`)
+)
+
+// PlaintextSecret is a password-like config which is not yet a reference to an encrypted secret.
+type PlaintextSecret struct {
+	Path string
+	Key  string
+	// Encryptable is false for standalone JAAS files, which `EncryptConfigFileSecrets` does not support.
+	Encryptable bool
+}
+
+// ScanConfigFiles walks the given files and directories, and returns the plaintext secrets of every properties and JAAS file.
+// Keys of JAAS configurations embedded in properties files are formatted as "<config>/<login module>/<option>", like the keys
+// accepted by `EncryptConfigFileSecrets`. The excluded files, such as the secrets files themselves, are skipped.
+func ScanConfigFiles(paths, excludedPaths []string) ([]*PlaintextSecret, error) {
+	excluded := make(map[string]bool)
+	for _, path := range excludedPaths {
+		if path == "" {
+			continue
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		excluded[absPath] = true
+	}
+
+	var secrets []*PlaintextSecret
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return err
+			}
+			if excluded[absPath] {
+				return nil
+			}
+
+			var fileSecrets []*PlaintextSecret
+			switch ext := filepath.Ext(path); {
+			case slices.Contains(scannedPropertiesExtensions, ext):
+				fileSecrets, err = scanPropertiesFile(path)
+			case slices.Contains(scannedJAASExtensions, ext):
+				fileSecrets, err = scanJAASFile(path)
+			}
+			secrets = append(secrets, fileSecrets...)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+func scanPropertiesFile(path string) ([]*PlaintextSecret, error) {
+	configProps, err := LoadConfiguration(path, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []*PlaintextSecret
+	for _, key := range configProps.Keys() {
+		value, _ := configProps.Get(key)
+		if isPlaintextSecret(value) {
+			secrets = append(secrets, &PlaintextSecret{Path: path, Key: key, Encryptable: true})
+		}
+	}
+
+	sort.Slice(secrets, func(i, j int) bool { return secrets[i].Key < secrets[j].Key })
+	return secrets, nil
+}
+
+// scanJAASFile scans a standalone JAAS file, made of sections such as `KafkaServer { <login module> required <options>; };` whose
+// closing brace is on its own line.
+func scanJAASFile(path string) ([]*PlaintextSecret, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []*PlaintextSecret
+	for _, section := range jaasSectionRegex.FindAllStringSubmatch(string(data), -1) {
+		for _, entry := range strings.Split(section[2], ";") {
+			if strings.TrimSpace(entry) == "" {
+				continue
+			}
+
+			entryProps, err := NewJAASParser().ParseJAASConfigurationEntry(strings.TrimSpace(entry)+";", section[1])
+			if err != nil {
+				return nil, err
+			}
+
+			for _, key := range entryProps.Keys() {
+				value, _ := entryProps.Get(key)
+				option := key[strings.LastIndex(key, KeySeparator)+1:]
+				if passwordKeyRegex.MatchString(option) && isPlaintextSecret(value) {
+					secrets = append(secrets, &PlaintextSecret{Path: path, Key: key})
+				}
+			}
+		}
+	}
+	return secrets, nil
+}
+
+// isPlaintextSecret returns false for empty values, references to encrypted secrets, and values which are already encrypted.
+func isPlaintextSecret(value string) bool {
+	value = strings.Trim(strings.TrimSpace(value), `"`)
+	return value != "" && !passwordRegex.MatchString(value) && !cipherRegex.MatchString(value)
+}
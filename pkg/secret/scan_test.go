@@ -0,0 +1,79 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanConfigFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "connect"), 0755))
+
+	serverProperties := filepath.Join(dir, "server.properties")
+	require.NoError(t, os.WriteFile(serverProperties, []byte(`ssl.keystore.password=password
+ssl.truststore.password=${securepass:/secrets.properties:server.properties/ssl.truststore.password}
+ssl.keystore.location=/etc/keystore.jks
+listener.name.sasl_ssl.plain.sasl.jaas.config=org.apache.kafka.common.security.plain.PlainLoginModule required username="admin" password="admin-secret";
+`), 0644))
+
+	workerProperties := filepath.Join(dir, "connect", "worker.properties")
+	require.NoError(t, os.WriteFile(workerProperties, []byte("producer.ssl.key.password=key-password\n"), 0644))
+
+	jaasConf := filepath.Join(dir, "connect", "kafka_server_jaas.conf")
+	require.NoError(t, os.WriteFile(jaasConf, []byte(`KafkaServer {
+    org.apache.kafka.common.security.plain.PlainLoginModule required
+    username="admin"
+    password="admin-secret";
+};
+`), 0644))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("password=ignored\n"), 0644))
+
+	secrets, err := ScanConfigFiles([]string{dir}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*PlaintextSecret{
+		{Path: jaasConf, Key: "KafkaServer/org.apache.kafka.common.security.plain.PlainLoginModule/password"},
+		{Path: workerProperties, Key: "producer.ssl.key.password", Encryptable: true},
+		{Path: serverProperties, Key: "listener.name.sasl_ssl.plain.sasl.jaas.config/org.apache.kafka.common.security.plain.PlainLoginModule/password", Encryptable: true},
+		{Path: serverProperties, Key: "ssl.keystore.password", Encryptable: true},
+	}, secrets)
+
+	_, err = ScanConfigFiles([]string{filepath.Join(dir, "missing")}, nil)
+	require.Error(t, err)
+}
+
+func TestScanConfigFiles_Encrypted(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "server.properties")
+	localSecretsFile := filepath.Join(dir, "secrets.properties")
+	require.NoError(t, os.WriteFile(configFile, []byte("ssl.keystore.password=password\n"), 0644))
+
+	t.Setenv(ConfluentKeyEnvVar, "")
+	plugin := &PasswordProtectionSuite{Clock: clockwork.NewFakeClock()}
+	masterKey, err := plugin.CreateMasterKey("passphrase", localSecretsFile, &envSecretBackend{envVar: ConfluentKeyEnvVar})
+	require.NoError(t, err)
+	t.Setenv(ConfluentKeyEnvVar, masterKey)
+	require.NoError(t, plugin.EncryptConfigFileSecrets(configFile, localSecretsFile, localSecretsFile, ""))
+
+	encryptedFile := filepath.Join(dir, "encrypted.properties")
+	require.NoError(t, os.WriteFile(encryptedFile, []byte("ssl.key.password=ENC[AES/GCM/NoPadding,data:bm90IGEgcmVhbCBjaXBoZXI=,iv:aXY=,type:str]\n"), 0644))
+
+	secrets, err := ScanConfigFiles([]string{dir}, []string{localSecretsFile})
+	require.NoError(t, err)
+	require.Empty(t, secrets)
+
+	// Relative paths are excluded as well
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	relativeEncryptedFile, err := filepath.Rel(wd, encryptedFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(encryptedFile, []byte("ssl.key.password=password\n"), 0644))
+
+	secrets, err = ScanConfigFiles([]string{dir}, []string{localSecretsFile, relativeEncryptedFile})
+	require.NoError(t, err)
+	require.Empty(t, secrets)
+}
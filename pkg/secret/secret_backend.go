@@ -0,0 +1,319 @@
+package secret
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+
+	"github.com/confluentinc/properties"
+
+	"github.com/confluentinc/cli/v3/pkg/errors"
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+// Secret Backends
+const (
+	EnvBackend     = "env"
+	FileBackend    = "file"
+	CommandBackend = "command"
+	VaultBackend   = "vault"
+
+	CommandBackendEnvVar = "CONFLUENT_SECURITY_MASTER_KEY_COMMAND"
+	VaultAddrEnvVar      = "VAULT_ADDR"
+	VaultTokenEnvVar     = "VAULT_TOKEN"
+	vaultMasterKeyField  = "master_key"
+	vaultRequestTimeout  = 10 * time.Second
+)
+
+var SecretBackends = []string{EnvBackend, FileBackend, CommandBackend, VaultBackend}
+
+// SecretBackend is the source of the master key. The backend of a secrets file is stored in its metadata, so that
+// every command on the file reads the master key from the same place.
+type SecretBackend interface {
+	Name() string
+	Location() string
+	LoadMasterKey() (string, error)
+	// StoreMasterKey saves a newly generated master key. Backends which cannot be written to leave this to the user.
+	StoreMasterKey(masterKey string) error
+}
+
+// NewSecretBackend returns the backend with the given name. The location is the environment variable of the "env"
+// backend, the key file of the "file" backend, the command line of the "command" backend, and the path of the secret
+// in the "vault" backend. The command line defaults to the `CONFLUENT_SECURITY_MASTER_KEY_COMMAND` environment variable,
+// and is never recorded in the secrets file so that editing the file cannot run arbitrary commands. Likewise, the
+// address of the Vault server is only read from the `VAULT_ADDR` environment variable, so that editing the file
+// cannot send the Vault token to another server.
+func NewSecretBackend(name, location string) (SecretBackend, error) {
+	switch name {
+	case "", EnvBackend:
+		if location == "" {
+			location = ConfluentKeyEnvVar
+		}
+		return &envSecretBackend{envVar: location}, nil
+	case FileBackend:
+		if location == "" {
+			return nil, fmt.Errorf("the %s backend requires the path of a key file", FileBackend)
+		}
+		return &fileSecretBackend{path: location}, nil
+	case CommandBackend:
+		if location == "" {
+			location = os.Getenv(CommandBackendEnvVar)
+		}
+		args, err := shlex.Split(location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse command `%s`: %w", location, err)
+		}
+		if len(args) == 0 {
+			return nil, errors.NewErrorWithSuggestions(fmt.Sprintf("the %s backend requires a command which prints the master key", CommandBackend), fmt.Sprintf("Set the environment variable `%s` to the command.", CommandBackendEnvVar))
+		}
+		return &commandSecretBackend{command: location, args: args}, nil
+	case VaultBackend:
+		if location == "" {
+			return nil, fmt.Errorf("the %s backend requires the path of a secret", VaultBackend)
+		}
+		return &vaultSecretBackend{path: location, client: &http.Client{Timeout: vaultRequestTimeout}}, nil
+	default:
+		return nil, fmt.Errorf(`unknown secret backend "%s"`, name)
+	}
+}
+
+// LoadSecretBackend returns the backend recorded in a secrets file, which defaults to the environment variable.
+func LoadSecretBackend(localSecureConfigPath string) (SecretBackend, error) {
+	if !utils.DoesPathExist(localSecureConfigPath) {
+		return NewSecretBackend(EnvBackend, "")
+	}
+
+	secureConfigProps, err := utils.LoadPropertiesFile(localSecureConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadSecretBackendFromSecureProps(secureConfigProps)
+}
+
+func loadSecretBackendFromSecureProps(secureConfigProps *properties.Properties) (SecretBackend, error) {
+	secureConfigProps.DisableExpansion = true
+	name := secureConfigProps.GetString(MetadataMEKBackend, EnvBackend)
+	if name == CommandBackend {
+		return NewSecretBackend(CommandBackend, "")
+	}
+	location := secureConfigProps.GetString(MetadataMEKBackendLocation, "")
+	return NewSecretBackend(name, location)
+}
+
+func setSecretBackend(secureConfigProps *properties.Properties, backend SecretBackend) error {
+	if _, _, err := secureConfigProps.Set(MetadataMEKBackend, backend.Name()); err != nil {
+		return err
+	}
+	_, _, err := secureConfigProps.Set(MetadataMEKBackendLocation, backend.Location())
+	return err
+}
+
+type envSecretBackend struct {
+	envVar string
+}
+
+func (b *envSecretBackend) Name() string {
+	return EnvBackend
+}
+
+func (b *envSecretBackend) Location() string {
+	return b.envVar
+}
+
+func (b *envSecretBackend) LoadMasterKey() (string, error) {
+	masterKey, found := os.LookupEnv(b.envVar)
+	if !found {
+		return "", errors.NewErrorWithSuggestions(fmt.Sprintf(errors.MasterKeyNotExportedErrorMsg, b.envVar), fmt.Sprintf(errors.MasterKeyNotExportedSuggestions, b.envVar))
+	}
+	return masterKey, nil
+}
+
+func (b *envSecretBackend) StoreMasterKey(_ string) error {
+	return nil
+}
+
+type fileSecretBackend struct {
+	path string
+}
+
+func (b *fileSecretBackend) Name() string {
+	return FileBackend
+}
+
+func (b *fileSecretBackend) Location() string {
+	return b.path
+}
+
+func (b *fileSecretBackend) LoadMasterKey() (string, error) {
+	data, err := os.ReadFile(b.path)
+	if err != nil {
+		return "", fmt.Errorf(`failed to read master key from "%s": %w`, b.path, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (b *fileSecretBackend) StoreMasterKey(masterKey string) error {
+	if err := os.MkdirAll(filepath.Dir(b.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(b.path, []byte(masterKey+"\n"), 0600)
+}
+
+type commandSecretBackend struct {
+	command string
+	args    []string
+}
+
+func (b *commandSecretBackend) Name() string {
+	return CommandBackend
+}
+
+// Location is empty, since the command is read from the environment rather than the secrets file.
+func (b *commandSecretBackend) Location() string {
+	return ""
+}
+
+// LoadMasterKey runs the command, without a shell, and reads the master key from its standard output.
+func (b *commandSecretBackend) LoadMasterKey() (string, error) {
+	stderr := new(bytes.Buffer)
+	cmd := exec.Command(b.args[0], b.args[1:]...)
+	cmd.Stderr = stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to read master key from command `%s`: %w: %s", b.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	masterKey := strings.TrimSpace(string(out))
+	if masterKey == "" {
+		return "", fmt.Errorf("command `%s` did not print a master key", b.command)
+	}
+	return masterKey, nil
+}
+
+func (b *commandSecretBackend) StoreMasterKey(_ string) error {
+	return nil
+}
+
+// vaultSecretBackend reads and writes the master key in a HashiCorp Vault KV version 2 secret, such as
+// "secret/data/confluent". The address of the server is read from the `VAULT_ADDR` environment variable, and the token
+// from the `VAULT_TOKEN` environment variable.
+type vaultSecretBackend struct {
+	path   string
+	client *http.Client
+}
+
+type vaultSecret struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+func (b *vaultSecretBackend) Name() string {
+	return VaultBackend
+}
+
+func (b *vaultSecretBackend) Location() string {
+	return b.path
+}
+
+// url returns the URL of the secret on the server at `VAULT_ADDR`. Secrets files written by earlier versions record
+// the full URL of the secret, which is only used if it is on that server.
+func (b *vaultSecretBackend) url() (string, error) {
+	addr := os.Getenv(VaultAddrEnvVar)
+	if addr == "" {
+		return "", errors.NewErrorWithSuggestions(fmt.Sprintf("the %s backend requires the address of a Vault server", VaultBackend), fmt.Sprintf("Set the environment variable `%s` to the address of the Vault server, such as \"http://localhost:8200\".", VaultAddrEnvVar))
+	}
+
+	server, err := url.Parse(addr)
+	if err != nil {
+		return "", fmt.Errorf(`failed to parse Vault address "%s": %w`, addr, err)
+	}
+
+	secret, err := url.Parse(b.path)
+	if err != nil {
+		return "", fmt.Errorf(`failed to parse secret "%s": %w`, b.path, err)
+	}
+	if secret.IsAbs() {
+		if secret.Scheme != server.Scheme || secret.Host != server.Host {
+			return "", fmt.Errorf(`secret "%s" is not on the Vault server "%s" from %s`, b.path, addr, VaultAddrEnvVar)
+		}
+		return secret.String(), nil
+	}
+
+	return server.JoinPath("v1", b.path).String(), nil
+}
+
+func (b *vaultSecretBackend) LoadMasterKey() (string, error) {
+	body, err := b.do(http.MethodGet, nil)
+	if err != nil {
+		return "", err
+	}
+
+	secret := new(vaultSecret)
+	if err := json.Unmarshal(body, secret); err != nil {
+		return "", fmt.Errorf(`failed to parse secret "%s": %w`, b.path, err)
+	}
+
+	masterKey, ok := secret.Data.Data[vaultMasterKeyField]
+	if !ok {
+		return "", fmt.Errorf(`secret "%s" does not have a "%s" field`, b.path, vaultMasterKeyField)
+	}
+	return masterKey, nil
+}
+
+func (b *vaultSecretBackend) StoreMasterKey(masterKey string) error {
+	body, err := json.Marshal(map[string]any{"data": map[string]string{vaultMasterKeyField: masterKey}})
+	if err != nil {
+		return err
+	}
+
+	_, err = b.do(http.MethodPost, body)
+	return err
+}
+
+func (b *vaultSecretBackend) do(method string, body []byte) ([]byte, error) {
+	token, found := os.LookupEnv(VaultTokenEnvVar)
+	if !found {
+		return nil, errors.NewErrorWithSuggestions(fmt.Sprintf("the %s backend requires a token", VaultBackend), fmt.Sprintf("Set the environment variable `%s` to a token with access to the secret.", VaultTokenEnvVar))
+	}
+
+	secretUrl, err := b.url()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, secretUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf(`request to secret "%s" failed with status %d: %s`, b.path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
@@ -0,0 +1,189 @@
+package secret
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/confluentinc/properties"
+)
+
+func TestNewSecretBackend(t *testing.T) {
+	backend, err := NewSecretBackend("", "")
+	require.NoError(t, err)
+	require.Equal(t, &envSecretBackend{envVar: ConfluentKeyEnvVar}, backend)
+
+	t.Setenv(CommandBackendEnvVar, "")
+	for _, name := range []string{FileBackend, CommandBackend, VaultBackend} {
+		_, err := NewSecretBackend(name, "")
+		require.Error(t, err, name)
+	}
+
+	_, err = NewSecretBackend("kms", "")
+	require.EqualError(t, err, `unknown secret backend "kms"`)
+}
+
+func TestFileSecretBackend(t *testing.T) {
+	backend, err := NewSecretBackend(FileBackend, filepath.Join(t.TempDir(), "keys", "master.key"))
+	require.NoError(t, err)
+
+	_, err = backend.LoadMasterKey()
+	require.Error(t, err)
+
+	require.NoError(t, backend.StoreMasterKey("key"))
+	masterKey, err := backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "key", masterKey)
+}
+
+func TestCommandSecretBackend(t *testing.T) {
+	backend, err := NewSecretBackend(CommandBackend, "echo key")
+	require.NoError(t, err)
+
+	masterKey, err := backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "key", masterKey)
+
+	backend, err = NewSecretBackend(CommandBackend, `printf "%s" 'master key'`)
+	require.NoError(t, err)
+
+	masterKey, err = backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "master key", masterKey)
+
+	backend, err = NewSecretBackend(CommandBackend, "true")
+	require.NoError(t, err)
+
+	_, err = backend.LoadMasterKey()
+	require.EqualError(t, err, "command `true` did not print a master key")
+}
+
+func TestCommandSecretBackend_Environment(t *testing.T) {
+	t.Setenv(CommandBackendEnvVar, "echo key")
+
+	secureConfigProps := properties.NewProperties()
+	_, _, err := secureConfigProps.Set(MetadataMEKBackend, CommandBackend)
+	require.NoError(t, err)
+	_, _, err = secureConfigProps.Set(MetadataMEKBackendLocation, "touch pwned")
+	require.NoError(t, err)
+
+	backend, err := loadSecretBackendFromSecureProps(secureConfigProps)
+	require.NoError(t, err)
+	require.Empty(t, backend.Location())
+
+	masterKey, err := backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "key", masterKey)
+}
+
+func TestVaultSecretBackend(t *testing.T) {
+	secret := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/secret/data/confluent", r.URL.Path)
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			var req struct {
+				Data map[string]string `json:"data"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			secret = req.Data
+		case http.MethodGet:
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": secret}}))
+		}
+	}))
+	defer server.Close()
+
+	backend, err := NewSecretBackend(VaultBackend, "secret/data/confluent")
+	require.NoError(t, err)
+
+	_, err = backend.LoadMasterKey()
+	require.Error(t, err)
+
+	t.Setenv(VaultAddrEnvVar, server.URL)
+	t.Setenv(VaultTokenEnvVar, "wrong")
+	require.Error(t, backend.StoreMasterKey("key"))
+
+	t.Setenv(VaultTokenEnvVar, "token")
+	_, err = backend.LoadMasterKey()
+	require.Error(t, err)
+
+	require.NoError(t, backend.StoreMasterKey("key"))
+	masterKey, err := backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "key", masterKey)
+}
+
+func TestVaultSecretBackend_Address(t *testing.T) {
+	requests := 0
+	attacker := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requests++
+	}))
+	defer attacker.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"data": map[string]string{vaultMasterKeyField: "key"}}}))
+	}))
+	defer server.Close()
+
+	t.Setenv(VaultAddrEnvVar, server.URL)
+	t.Setenv(VaultTokenEnvVar, "token")
+
+	secureConfigProps := properties.NewProperties()
+	_, _, err := secureConfigProps.Set(MetadataMEKBackend, VaultBackend)
+	require.NoError(t, err)
+	_, _, err = secureConfigProps.Set(MetadataMEKBackendLocation, attacker.URL+"/v1/secret/data/confluent")
+	require.NoError(t, err)
+
+	backend, err := loadSecretBackendFromSecureProps(secureConfigProps)
+	require.NoError(t, err)
+	_, err = backend.LoadMasterKey()
+	require.Error(t, err)
+	require.Zero(t, requests)
+
+	_, _, err = secureConfigProps.Set(MetadataMEKBackendLocation, server.URL+"/v1/secret/data/confluent")
+	require.NoError(t, err)
+
+	backend, err = loadSecretBackendFromSecureProps(secureConfigProps)
+	require.NoError(t, err)
+	masterKey, err := backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, "key", masterKey)
+}
+
+func TestPasswordProtectionSuite_FileSecretBackend(t *testing.T) {
+	dir := t.TempDir()
+	localSecretsFile := filepath.Join(dir, "secrets.properties")
+	configFile := filepath.Join(dir, "config.properties")
+	outputFile := filepath.Join(dir, "output.properties")
+	require.NoError(t, os.WriteFile(configFile, []byte("ssl.keystore.password=password\n"), 0644))
+
+	backend, err := NewSecretBackend(FileBackend, filepath.Join(dir, "master.key"))
+	require.NoError(t, err)
+
+	plugin := &PasswordProtectionSuite{Clock: clockwork.NewFakeClock()}
+	masterKey, err := plugin.CreateMasterKey("passphrase", localSecretsFile, backend)
+	require.NoError(t, err)
+
+	storedMasterKey, err := backend.LoadMasterKey()
+	require.NoError(t, err)
+	require.Equal(t, masterKey, storedMasterKey)
+
+	loadedBackend, err := LoadSecretBackend(localSecretsFile)
+	require.NoError(t, err)
+	require.Equal(t, backend, loadedBackend)
+
+	require.NoError(t, plugin.EncryptConfigFileSecrets(configFile, localSecretsFile, localSecretsFile, ""))
+	require.NoError(t, plugin.DecryptConfigFileSecrets(configFile, localSecretsFile, outputFile, ""))
+	validateTextFileContents(outputFile, "ssl.keystore.password = password\n", require.New(t))
+}
@@ -0,0 +1,365 @@
+package serdes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+var (
+	avroPrimitiveTypes = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+	// avroPromotions lists, for each writer type, the reader types it can be promoted to.
+	avroPromotions = map[string][]string{
+		"int":    {"long", "float", "double"},
+		"long":   {"float", "double"},
+		"float":  {"double"},
+		"string": {"bytes"},
+		"bytes":  {"string"},
+	}
+)
+
+// avroSchema is a parsed Avro schema. Named types are parsed once and shared by every reference to them.
+type avroSchema struct {
+	typ         string
+	name        string
+	aliases     []string
+	fields      []*avroField
+	symbols     []string
+	hasDefault  bool
+	items       *avroSchema
+	values      *avroSchema
+	size        int
+	branches    []*avroSchema
+	description string
+}
+
+type avroField struct {
+	name         string
+	aliases      []string
+	schema       *avroSchema
+	hasDefault   bool
+	defaultValue any
+}
+
+// parseAvroSchemaFile parses an Avro schema file. The named types of its references are parsed first, so that the schema
+// can use them.
+func parseAvroSchemaFile(path string, referencePathMap map[string]string) (any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the schema with the same library used to serialize messages, which cannot resolve references.
+	if len(referencePathMap) == 0 {
+		if _, err := goavro.NewCodec(string(data)); err != nil {
+			return nil, err
+		}
+	}
+
+	var node any
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+
+	names, err := parseAvroReferences(referencePathMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAvroSchema(node, "", names)
+}
+
+// parseAvroReferences returns the named types of the referenced schemas. References may use each other's types, so the
+// schemas which cannot be parsed yet are retried until no more progress is made.
+func parseAvroReferences(referencePathMap map[string]string) (map[string]*avroSchema, error) {
+	names := make(map[string]*avroSchema)
+
+	pending := make([]string, 0, len(referencePathMap))
+	for name := range referencePathMap {
+		pending = append(pending, name)
+	}
+	slices.Sort(pending)
+
+	for len(pending) > 0 {
+		var remaining []string
+		var lastErr error
+		for _, name := range pending {
+			data, err := os.ReadFile(referencePathMap[name])
+			if err != nil {
+				return nil, err
+			}
+
+			var node any
+			if err := json.Unmarshal(data, &node); err != nil {
+				return nil, fmt.Errorf(`failed to parse reference "%s": %w`, name, err)
+			}
+
+			// Parse into a copy, so that a schema which fails to parse does not leave some of its types behind.
+			referenceNames := make(map[string]*avroSchema, len(names))
+			for fullName, schema := range names {
+				referenceNames[fullName] = schema
+			}
+			if _, err := parseAvroSchema(node, "", referenceNames); err != nil {
+				remaining = append(remaining, name)
+				lastErr = fmt.Errorf(`failed to parse reference "%s": %w`, name, err)
+				continue
+			}
+			names = referenceNames
+		}
+
+		if len(remaining) == len(pending) {
+			return nil, lastErr
+		}
+		pending = remaining
+	}
+
+	return names, nil
+}
+
+func parseAvroSchema(node any, namespace string, names map[string]*avroSchema) (*avroSchema, error) {
+	switch node := node.(type) {
+	case string:
+		if slices.Contains(avroPrimitiveTypes, node) {
+			return &avroSchema{typ: node, description: node}, nil
+		}
+		if schema, ok := names[avroFullName(node, namespace)]; ok {
+			return schema, nil
+		}
+		if schema, ok := names[node]; ok {
+			return schema, nil
+		}
+		return nil, fmt.Errorf(`unknown type "%s"`, node)
+	case []any:
+		schema := &avroSchema{typ: "union"}
+		var descriptions []string
+		for _, branchNode := range node {
+			branch, err := parseAvroSchema(branchNode, namespace, names)
+			if err != nil {
+				return nil, err
+			}
+			schema.branches = append(schema.branches, branch)
+			descriptions = append(descriptions, branch.description)
+		}
+		schema.description = fmt.Sprintf("union [%s]", strings.Join(descriptions, ", "))
+		return schema, nil
+	case map[string]any:
+		return parseAvroComplexSchema(node, namespace, names)
+	default:
+		return nil, fmt.Errorf("invalid schema %v", node)
+	}
+}
+
+func parseAvroComplexSchema(node map[string]any, namespace string, names map[string]*avroSchema) (*avroSchema, error) {
+	typ, ok := node["type"].(string)
+	if !ok {
+		return parseAvroSchema(node["type"], namespace, names)
+	}
+
+	schema := &avroSchema{typ: typ, description: typ}
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		if ns, ok := node["namespace"].(string); ok {
+			namespace = ns
+		}
+		name, _ := node["name"].(string)
+		schema.name = avroFullName(name, namespace)
+		if i := strings.LastIndex(schema.name, "."); i >= 0 {
+			namespace = schema.name[:i]
+		}
+		schema.description = fmt.Sprintf(`%s "%s"`, typ, schema.name)
+		schema.aliases = parseAvroAliases(node["aliases"], namespace)
+		names[schema.name] = schema
+	case "array":
+		items, err := parseAvroSchema(node["items"], namespace, names)
+		if err != nil {
+			return nil, err
+		}
+		schema.items = items
+		return schema, nil
+	case "map":
+		values, err := parseAvroSchema(node["values"], namespace, names)
+		if err != nil {
+			return nil, err
+		}
+		schema.values = values
+		return schema, nil
+	default:
+		// A primitive type, possibly annotated with a logical type.
+		return parseAvroSchema(typ, namespace, names)
+	}
+
+	switch typ {
+	case "record", "error":
+		schema.typ = "record"
+		fieldNodes, _ := node["fields"].([]any)
+		for _, fieldNode := range fieldNodes {
+			fieldMap, _ := fieldNode.(map[string]any)
+			name, _ := fieldMap["name"].(string)
+			fieldSchema, err := parseAvroSchema(fieldMap["type"], namespace, names)
+			if err != nil {
+				return nil, err
+			}
+			defaultValue, hasDefault := fieldMap["default"]
+			schema.fields = append(schema.fields, &avroField{
+				name:         name,
+				aliases:      parseAvroAliases(fieldMap["aliases"], ""),
+				schema:       fieldSchema,
+				hasDefault:   hasDefault,
+				defaultValue: defaultValue,
+			})
+		}
+	case "enum":
+		symbols, _ := node["symbols"].([]any)
+		for _, symbol := range symbols {
+			if symbol, ok := symbol.(string); ok {
+				schema.symbols = append(schema.symbols, symbol)
+			}
+		}
+		_, schema.hasDefault = node["default"]
+	case "fixed":
+		size, _ := node["size"].(float64)
+		schema.size = int(size)
+	}
+
+	return schema, nil
+}
+
+func parseAvroAliases(node any, namespace string) []string {
+	aliasNodes, _ := node.([]any)
+	aliases := make([]string, 0, len(aliasNodes))
+	for _, alias := range aliasNodes {
+		if alias, ok := alias.(string); ok {
+			if namespace != "" {
+				alias = avroFullName(alias, namespace)
+			}
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func avroSimpleName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// avroCompatibilityChecker follows the schema resolution rules of the Avro specification.
+type avroCompatibilityChecker struct{}
+
+func (c *avroCompatibilityChecker) check(reader, writer any) []Incompatibility {
+	resolver := &avroResolver{checked: make(map[[2]*avroSchema]bool)}
+	resolver.resolve(reader.(*avroSchema), writer.(*avroSchema), "$")
+	return resolver.incompatibilities
+}
+
+type avroResolver struct {
+	checked           map[[2]*avroSchema]bool
+	incompatibilities []Incompatibility
+}
+
+func (r *avroResolver) report(path, format string, args ...any) {
+	r.incompatibilities = append(r.incompatibilities, Incompatibility{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+// matches reports whether the reader schema can read the writer schema, without reporting anything.
+func (r *avroResolver) matches(reader, writer *avroSchema) bool {
+	trial := &avroResolver{checked: make(map[[2]*avroSchema]bool)}
+	for pair := range r.checked {
+		trial.checked[pair] = true
+	}
+	trial.resolve(reader, writer, "")
+	return len(trial.incompatibilities) == 0
+}
+
+func (r *avroResolver) resolve(reader, writer *avroSchema, path string) {
+	// Named types may be recursive, so each pair is only checked once.
+	pair := [2]*avroSchema{reader, writer}
+	if r.checked[pair] {
+		return
+	}
+	r.checked[pair] = true
+
+	if writer.typ == "union" {
+		for _, branch := range writer.branches {
+			if reader.typ == "union" {
+				if !slices.ContainsFunc(reader.branches, func(readerBranch *avroSchema) bool { return r.matches(readerBranch, branch) }) {
+					r.report(path, "the reader %s cannot read the writer type %s", reader.description, branch.description)
+				}
+			} else {
+				r.resolve(reader, branch, path)
+			}
+		}
+		return
+	}
+
+	if reader.typ == "union" {
+		if !slices.ContainsFunc(reader.branches, func(readerBranch *avroSchema) bool { return r.matches(readerBranch, writer) }) {
+			r.report(path, "the reader %s cannot read the writer type %s", reader.description, writer.description)
+		}
+		return
+	}
+
+	if reader.typ != writer.typ {
+		if !slices.Contains(avroPromotions[writer.typ], reader.typ) {
+			r.report(path, "the reader type %s cannot read the writer type %s", reader.description, writer.description)
+		}
+		return
+	}
+
+	if reader.name != "" && avroSimpleName(reader.name) != avroSimpleName(writer.name) && !slices.Contains(reader.aliases, writer.name) {
+		r.report(path, `the reader type name "%s" does not match the writer type name "%s"`, reader.name, writer.name)
+		return
+	}
+
+	switch reader.typ {
+	case "record":
+		r.resolveRecord(reader, writer, path)
+	case "enum":
+		if !reader.hasDefault {
+			for _, symbol := range writer.symbols {
+				if !slices.Contains(reader.symbols, symbol) {
+					r.report(path, `the reader enum "%s" is missing the symbol "%s" and has no default`, reader.name, symbol)
+				}
+			}
+		}
+	case "fixed":
+		if reader.size != writer.size {
+			r.report(path, `the reader size %d of fixed "%s" does not match the writer size %d`, reader.size, reader.name, writer.size)
+		}
+	case "array":
+		r.resolve(reader.items, writer.items, path+"[*]")
+	case "map":
+		r.resolve(reader.values, writer.values, path+".*")
+	}
+}
+
+func (r *avroResolver) resolveRecord(reader, writer *avroSchema, path string) {
+	for _, readerField := range reader.fields {
+		fieldPath := fmt.Sprintf("%s.%s", path, readerField.name)
+
+		i := slices.IndexFunc(writer.fields, func(writerField *avroField) bool { return writerField.name == readerField.name })
+		if i < 0 {
+			i = slices.IndexFunc(writer.fields, func(writerField *avroField) bool { return slices.Contains(readerField.aliases, writerField.name) })
+		}
+
+		if i < 0 {
+			if !readerField.hasDefault {
+				r.report(fieldPath, `the reader field "%s" is missing from the writer record "%s" and has no default`, readerField.name, writer.name)
+			}
+			continue
+		}
+
+		r.resolve(readerField.schema, writer.fields[i].schema, fieldPath)
+	}
+}
@@ -1,6 +1,7 @@
 package serdes
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -35,6 +36,28 @@ func (a *AvroSerializationProvider) GetSchemaName() string {
 	return avroSchemaBackendName
 }
 
+// GetRecordName returns the full name of a named schema, or the type name of any other schema.
+func (a *AvroSerializationProvider) GetRecordName() (string, error) {
+	// The canonical form of a schema replaces each name with its full name and drops the namespace.
+	var schema any
+	if err := json.Unmarshal([]byte(a.codec.CanonicalSchema()), &schema); err != nil {
+		return "", err
+	}
+
+	switch schema := schema.(type) {
+	case string:
+		return schema, nil
+	case map[string]any:
+		if name, ok := schema["name"].(string); ok {
+			return name, nil
+		}
+		if name, ok := schema["type"].(string); ok {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("the avro schema does not have a record name")
+}
+
 func (a *AvroSerializationProvider) Serialize(str string) ([]byte, error) {
 	textual := []byte(str)
 
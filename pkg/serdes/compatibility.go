@@ -0,0 +1,126 @@
+package serdes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/confluentinc/cli/v3/pkg/utils"
+)
+
+const (
+	CompatibilityBackward           = "BACKWARD"
+	CompatibilityBackwardTransitive = "BACKWARD_TRANSITIVE"
+	CompatibilityForward            = "FORWARD"
+	CompatibilityForwardTransitive  = "FORWARD_TRANSITIVE"
+	CompatibilityFull               = "FULL"
+	CompatibilityFullTransitive     = "FULL_TRANSITIVE"
+	CompatibilityNone               = "NONE"
+)
+
+var CompatibilityLevels = []string{
+	CompatibilityBackward,
+	CompatibilityBackwardTransitive,
+	CompatibilityForward,
+	CompatibilityForwardTransitive,
+	CompatibilityFull,
+	CompatibilityFullTransitive,
+	CompatibilityNone,
+}
+
+const (
+	// DirectionBackward checks that the new schema can read data written with an old schema.
+	DirectionBackward = "backward"
+	// DirectionForward checks that an old schema can read data written with the new schema.
+	DirectionForward = "forward"
+)
+
+// Incompatibility is a change between two versions of a schema which breaks compatibility in one direction.
+type Incompatibility struct {
+	OldSchema string
+	Direction string
+	Path      string
+	Reason    string
+}
+
+// compatibilityChecker returns the changes which prevent a reader schema from reading data written with a writer schema.
+type compatibilityChecker interface {
+	check(reader, writer any) []Incompatibility
+}
+
+// schemaFormat parses schema files of one format, and compares the parsed schemas.
+type schemaFormat struct {
+	parse   func(string, map[string]string) (any, error)
+	checker compatibilityChecker
+	flatten func(any) map[string]schemaElement
+}
+
+var schemaFormats = map[string]*schemaFormat{
+	"avro":     {parse: parseAvroSchemaFile, checker: new(avroCompatibilityChecker), flatten: flattenAvroSchema},
+	"json":     {parse: parseJsonSchemaFile, checker: new(jsonCompatibilityChecker), flatten: flattenJsonSchema},
+	"protobuf": {parse: parseProtobufSchemaFile, checker: new(protobufCompatibilityChecker), flatten: flattenProtobufSchema},
+}
+
+func getSchemaFormat(format string) (*schemaFormat, error) {
+	schemaFormat, ok := schemaFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("invalid schema type \"%s\": must be \"avro\", \"json\", or \"protobuf\"", format)
+	}
+	return schemaFormat, nil
+}
+
+// CheckCompatibility checks a new schema against the old versions of a schema, oldest first, under a compatibility level,
+// without Schema Registry. Non-transitive levels only check the latest old version. The schemas are files in the
+// "avro", "json", or "protobuf" format, and the returned incompatibilities are listed in the order they were found.
+func CheckCompatibility(format, level string, oldSchemaPaths []string, newSchemaPath string) ([]Incompatibility, error) {
+	level = strings.ToUpper(level)
+	if !slices.Contains(CompatibilityLevels, level) {
+		return nil, fmt.Errorf("invalid compatibility level \"%s\": must be %s", level, utils.ArrayToCommaDelimitedString(CompatibilityLevels, "or"))
+	}
+
+	schemaFormat, err := getSchemaFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	newSchema, err := schemaFormat.parse(newSchemaPath, map[string]string{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema \"%s\": %w", newSchemaPath, err)
+	}
+
+	if level == CompatibilityNone || len(oldSchemaPaths) == 0 {
+		return nil, nil
+	}
+	if !strings.HasSuffix(level, "_TRANSITIVE") {
+		oldSchemaPaths = oldSchemaPaths[len(oldSchemaPaths)-1:]
+	}
+
+	var incompatibilities []Incompatibility
+	for _, oldSchemaPath := range oldSchemaPaths {
+		oldSchema, err := schemaFormat.parse(oldSchemaPath, map[string]string{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse schema \"%s\": %w", oldSchemaPath, err)
+		}
+
+		var found []Incompatibility
+		if !strings.HasPrefix(level, CompatibilityForward) {
+			for _, incompatibility := range schemaFormat.checker.check(newSchema, oldSchema) {
+				incompatibility.Direction = DirectionBackward
+				found = append(found, incompatibility)
+			}
+		}
+		if !strings.HasPrefix(level, CompatibilityBackward) {
+			for _, incompatibility := range schemaFormat.checker.check(oldSchema, newSchema) {
+				incompatibility.Direction = DirectionForward
+				found = append(found, incompatibility)
+			}
+		}
+
+		for _, incompatibility := range found {
+			incompatibility.OldSchema = oldSchemaPath
+			incompatibilities = append(incompatibilities, incompatibility)
+		}
+	}
+
+	return incompatibilities, nil
+}
@@ -0,0 +1,178 @@
+package serdes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeSchemas(t *testing.T, extension string, schemas ...string) []string {
+	dir := t.TempDir()
+	paths := make([]string, len(schemas))
+	for i, schema := range schemas {
+		paths[i] = filepath.Join(dir, fmt.Sprintf("v%d%s", i+1, extension))
+		require.NoError(t, os.WriteFile(paths[i], []byte(schema), 0644))
+	}
+	return paths
+}
+
+func TestCheckCompatibilityAvro(t *testing.T) {
+	v1 := `{"type":"record","name":"Employee","fields":[{"name":"name","type":"string"},{"name":"age","type":"int"}]}`
+	v2 := `{"type":"record","name":"Employee","fields":[{"name":"name","type":"string"},{"name":"age","type":"long"},{"name":"email","type":["null","string"],"default":null}]}`
+	v3 := `{"type":"record","name":"Employee","fields":[{"name":"name","type":"string"},{"name":"age","type":"long"},{"name":"email","type":["null","string"],"default":null},{"name":"team","type":"string"}]}`
+	paths := writeSchemas(t, ".avsc", v1, v2, v3)
+
+	incompatibilities, err := CheckCompatibility("avro", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+
+	incompatibilities, err = CheckCompatibility("avro", CompatibilityFull, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{{
+		OldSchema: paths[0],
+		Direction: DirectionForward,
+		Path:      "$.age",
+		Reason:    "the reader type int cannot read the writer type long",
+	}}, incompatibilities)
+
+	incompatibilities, err = CheckCompatibility("avro", CompatibilityBackwardTransitive, paths[:2], paths[2])
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 2)
+	for i, incompatibility := range incompatibilities {
+		require.Equal(t, paths[i], incompatibility.OldSchema)
+		require.Equal(t, "$.team", incompatibility.Path)
+	}
+
+	incompatibilities, err = CheckCompatibility("avro", CompatibilityNone, paths[:2], paths[2])
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+}
+
+func TestCheckCompatibilityAvroEnum(t *testing.T) {
+	v1 := `{"type":"enum","name":"Color","symbols":["RED","GREEN","BLUE"]}`
+	v2 := `{"type":"enum","name":"Color","symbols":["RED","GREEN"]}`
+	paths := writeSchemas(t, ".avsc", v1, v2)
+
+	incompatibilities, err := CheckCompatibility("avro", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Len(t, incompatibilities, 1)
+	require.Equal(t, `the reader enum "Color" is missing the symbol "BLUE" and has no default`, incompatibilities[0].Reason)
+}
+
+func TestCheckCompatibilityProtobuf(t *testing.T) {
+	v1 := `syntax = "proto3";
+package example;
+
+message Employee {
+  string name = 1;
+  int32 age = 2;
+  Address address = 3;
+}
+
+message Address {
+  string city = 1;
+}`
+	v2 := `syntax = "proto3";
+package example;
+
+message Employee {
+  string name = 1;
+  int64 age = 2;
+  string address = 3;
+}`
+	paths := writeSchemas(t, ".proto", v1, v2)
+
+	incompatibilities, err := CheckCompatibility("protobuf", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "Employee.address", Reason: `the field "address" changed from message "example.Address" to string`},
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "Address", Reason: `the message "Address" was removed`},
+	}, incompatibilities)
+}
+
+func TestCheckCompatibilityJson(t *testing.T) {
+	v1 := `{"type":"object","properties":{"name":{"type":"string"},"age":{"type":"integer"}},"additionalProperties":false}`
+	v2 := `{"type":"object","properties":{"name":{"type":"string","maxLength":10},"age":{"type":"number"}},"required":["name"],"additionalProperties":false}`
+	paths := writeSchemas(t, ".json", v1, v2)
+
+	incompatibilities, err := CheckCompatibility("json", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "#/properties/name", Reason: `"maxLength" was added`},
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "#/properties/name", Reason: `the property "name" became required`},
+	}, incompatibilities)
+
+	incompatibilities, err = CheckCompatibility("json", CompatibilityForward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionForward, Path: "#/properties/age", Reason: `the type "number" was removed`},
+	}, incompatibilities)
+}
+
+func TestCheckCompatibilityJsonDefinitions(t *testing.T) {
+	v1 := `{"type":"object","properties":{"address":{"$ref":"#/definitions/Address"}},"definitions":{"Address":{"type":"object","properties":{"city":{"type":"string"}}}}}`
+	v2 := `{"type":"object","properties":{"address":{"$ref":"#/definitions/Address"}},"definitions":{"Address":{"type":"object","properties":{"city":{"type":"string"}},"required":["city"]}}}`
+	v3 := `{"type":"object","properties":{"address":{"$ref":"#/$defs/Location"}},"$defs":{"Location":{"type":"object","properties":{"city":{"type":"string"}}}}}`
+	paths := writeSchemas(t, ".json", v1, v2, v3)
+
+	incompatibilities, err := CheckCompatibility("json", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "#/properties/address/properties/city", Reason: `the property "city" became required`},
+	}, incompatibilities)
+
+	incompatibilities, err = CheckCompatibility("json", CompatibilityFull, paths[:1], paths[2])
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+}
+
+func TestCheckCompatibilityJsonRecursive(t *testing.T) {
+	v1 := `{"$ref":"#/definitions/Node","definitions":{"Node":{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#/definitions/Node"}}}}}}`
+	v2 := `{"$ref":"#/definitions/Node","definitions":{"Node":{"type":"object","properties":{"children":{"type":"array","items":{"$ref":"#/definitions/Node"},"maxItems":2}}}}}`
+	paths := writeSchemas(t, ".json", v1, v2)
+
+	incompatibilities, err := CheckCompatibility("json", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "#/properties/children", Reason: `"maxItems" was added`},
+	}, incompatibilities)
+}
+
+func TestCheckCompatibilityJsonAllOf(t *testing.T) {
+	v1 := `{"allOf":[{"type":"object","properties":{"name":{"type":"string"}}},{"properties":{"age":{"type":"integer"}}}]}`
+	v2 := `{"allOf":[{"type":"object","properties":{"name":{"type":"string"}}},{"properties":{"age":{"type":"integer","minimum":0}}}]}`
+	v3 := `{"allOf":[{"type":"object","properties":{"name":{"type":"string"}}},{"properties":{"age":{"type":"integer"}}},{"required":["name"]}]}`
+	paths := writeSchemas(t, ".json", v1, v2, v3)
+
+	incompatibilities, err := CheckCompatibility("json", CompatibilityBackward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "#/allOf/1/properties/age", Reason: `"minimum" was added`},
+	}, incompatibilities)
+
+	incompatibilities, err = CheckCompatibility("json", CompatibilityForward, paths[:1], paths[1])
+	require.NoError(t, err)
+	require.Empty(t, incompatibilities)
+
+	incompatibilities, err = CheckCompatibility("json", CompatibilityBackward, paths[:1], paths[2])
+	require.NoError(t, err)
+	require.Equal(t, []Incompatibility{
+		{OldSchema: paths[0], Direction: DirectionBackward, Path: "#/allOf/2", Reason: `the branch was added to "allOf"`},
+	}, incompatibilities)
+}
+
+func TestCheckCompatibilityInvalid(t *testing.T) {
+	paths := writeSchemas(t, ".avsc", `{"type":"record","name":"Employee","fields":[]}`)
+
+	_, err := CheckCompatibility("avro", "SIDEWAYS", nil, paths[0])
+	require.Error(t, err)
+
+	_, err = CheckCompatibility("xml", CompatibilityBackward, nil, paths[0])
+	require.Error(t, err)
+
+	_, err = CheckCompatibility("avro", CompatibilityBackward, nil, filepath.Join(t.TempDir(), "missing.avsc"))
+	require.Error(t, err)
+}
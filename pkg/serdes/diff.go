@@ -0,0 +1,261 @@
+package serdes
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+const (
+	ChangeAdded          = "added"
+	ChangeRemoved        = "removed"
+	ChangeTypeChanged    = "type-changed"
+	ChangeDefaultChanged = "default-changed"
+)
+
+// SchemaChange is a field, message, or type which differs between two versions of a schema.
+type SchemaChange struct {
+	Path   string
+	Change string
+	Old    string
+	New    string
+}
+
+// schemaElement is a field, message, or type of a schema, identified by its path.
+type schemaElement struct {
+	Type    string
+	Default string
+}
+
+// DiffSchemas returns the structural changes from an old schema to a new schema, sorted by path. The schemas are files
+// in the "avro", "json", or "protobuf" format, along with the files of the schemas they reference.
+func DiffSchemas(format, oldSchemaPath string, oldReferencePathMap map[string]string, newSchemaPath string, newReferencePathMap map[string]string) ([]SchemaChange, error) {
+	schemaFormat, err := getSchemaFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	oldSchema, err := schemaFormat.parse(oldSchemaPath, oldReferencePathMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema \"%s\": %w", oldSchemaPath, err)
+	}
+
+	newSchema, err := schemaFormat.parse(newSchemaPath, newReferencePathMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema \"%s\": %w", newSchemaPath, err)
+	}
+
+	return diffSchemaElements(schemaFormat.flatten(oldSchema), schemaFormat.flatten(newSchema)), nil
+}
+
+func diffSchemaElements(oldElements, newElements map[string]schemaElement) []SchemaChange {
+	var paths []string
+	for path := range oldElements {
+		paths = append(paths, path)
+	}
+	for path := range newElements {
+		if _, ok := oldElements[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	var changes []SchemaChange
+	for _, path := range paths {
+		oldElement, inOld := oldElements[path]
+		newElement, inNew := newElements[path]
+		switch {
+		case !inOld:
+			changes = append(changes, SchemaChange{Path: path, Change: ChangeAdded, New: newElement.Type})
+		case !inNew:
+			changes = append(changes, SchemaChange{Path: path, Change: ChangeRemoved, Old: oldElement.Type})
+		default:
+			if oldElement.Type != newElement.Type {
+				changes = append(changes, SchemaChange{Path: path, Change: ChangeTypeChanged, Old: oldElement.Type, New: newElement.Type})
+			}
+			if oldElement.Default != newElement.Default {
+				changes = append(changes, SchemaChange{Path: path, Change: ChangeDefaultChanged, Old: oldElement.Default, New: newElement.Default})
+			}
+		}
+	}
+	return changes
+}
+
+func flattenAvroSchema(schema any) map[string]schemaElement {
+	root := schema.(*avroSchema)
+	elements := map[string]schemaElement{"$": {Type: describeAvroType(root)}}
+	flattenAvroType(root, "$", elements, make(map[*avroSchema]bool))
+	return elements
+}
+
+// flattenAvroType adds the fields of the records in a type. A recursive record is only expanded once on each path.
+func flattenAvroType(schema *avroSchema, path string, elements map[string]schemaElement, ancestors map[*avroSchema]bool) {
+	switch schema.typ {
+	case "record":
+		if ancestors[schema] {
+			return
+		}
+		ancestors[schema] = true
+		defer delete(ancestors, schema)
+
+		for _, field := range schema.fields {
+			fieldPath := fmt.Sprintf("%s.%s", path, field.name)
+			element := schemaElement{Type: describeAvroType(field.schema)}
+			if field.hasDefault {
+				element.Default = marshalDefault(field.defaultValue)
+			}
+			elements[fieldPath] = element
+			flattenAvroType(field.schema, fieldPath, elements, ancestors)
+		}
+	case "array":
+		flattenAvroType(schema.items, path+"[*]", elements, ancestors)
+	case "map":
+		flattenAvroType(schema.values, path+".*", elements, ancestors)
+	case "union":
+		for _, branch := range schema.branches {
+			flattenAvroType(branch, path, elements, ancestors)
+		}
+	}
+}
+
+func describeAvroType(schema *avroSchema) string {
+	switch schema.typ {
+	case "union":
+		branches := make([]string, len(schema.branches))
+		for i, branch := range schema.branches {
+			branches[i] = describeAvroType(branch)
+		}
+		return fmt.Sprintf("union [%s]", strings.Join(branches, ", "))
+	case "array":
+		return fmt.Sprintf("array<%s>", describeAvroType(schema.items))
+	case "map":
+		return fmt.Sprintf("map<%s>", describeAvroType(schema.values))
+	case "enum":
+		return fmt.Sprintf(`enum "%s" [%s]`, schema.name, strings.Join(schema.symbols, ", "))
+	case "fixed":
+		return fmt.Sprintf(`fixed "%s" (size %d)`, schema.name, schema.size)
+	default:
+		return schema.description
+	}
+}
+
+func flattenProtobufSchema(schema any) map[string]schemaElement {
+	file := schema.(*desc.FileDescriptor)
+	elements := map[string]schemaElement{"package": {Type: file.GetPackage()}}
+
+	enums := file.GetEnumTypes()
+	for _, message := range getAllMessageTypes(file.GetMessageTypes()) {
+		name := relativeName(message, file)
+		elements[name] = schemaElement{Type: "message"}
+		for _, field := range message.GetFields() {
+			elements[fmt.Sprintf("%s.%s", name, field.GetName())] = schemaElement{
+				Type:    describeProtobufField(field),
+				Default: field.AsFieldDescriptorProto().GetDefaultValue(),
+			}
+		}
+		enums = append(enums, message.GetNestedEnumTypes()...)
+	}
+
+	for _, enum := range enums {
+		name := relativeName(enum, file)
+		elements[name] = schemaElement{Type: "enum"}
+		for _, value := range enum.GetValues() {
+			elements[fmt.Sprintf("%s.%s", name, value.GetName())] = schemaElement{Type: fmt.Sprintf("value %d", value.GetNumber())}
+		}
+	}
+
+	return elements
+}
+
+func describeProtobufField(field *desc.FieldDescriptor) string {
+	var label string
+	switch {
+	case field.IsMap():
+		label = fmt.Sprintf("map<%s, %s>", describeFieldType(field.GetMapKeyType()), describeFieldType(field.GetMapValueType()))
+	case field.IsRepeated():
+		label = "repeated " + describeFieldType(field)
+	case field.IsRequired():
+		label = "required " + describeFieldType(field)
+	case field.IsProto3Optional():
+		label = "optional " + describeFieldType(field)
+	default:
+		label = describeFieldType(field)
+	}
+
+	description := fmt.Sprintf("%s = %d", label, field.GetNumber())
+	if oneOf := getOneOf(field); oneOf != nil {
+		description += fmt.Sprintf(` in oneof "%s"`, oneOf.GetName())
+	}
+	return description
+}
+
+func flattenJsonSchema(schema any) map[string]schemaElement {
+	elements := make(map[string]schemaElement)
+	flattenJsonNode(schema, "#", false, elements)
+	return elements
+}
+
+func flattenJsonNode(node any, path string, required bool, elements map[string]schemaElement) {
+	schema, ok := node.(map[string]any)
+	if !ok {
+		elements[path] = schemaElement{Type: fmt.Sprintf("%v", node)}
+		return
+	}
+
+	element := schemaElement{Type: describeJsonType(schema)}
+	if required {
+		element.Type += " (required)"
+	}
+	if defaultValue, ok := schema["default"]; ok {
+		element.Default = marshalDefault(defaultValue)
+	}
+	elements[path] = element
+
+	properties, _ := schema["properties"].(map[string]any)
+	requiredProperties := getJsonRequired(schema)
+	for _, name := range sortedKeys(properties) {
+		flattenJsonNode(properties[name], fmt.Sprintf("%s/properties/%s", path, name), slices.Contains(requiredProperties, name), elements)
+	}
+
+	if items, ok := schema["items"]; ok && !isJsonArray(items) {
+		flattenJsonNode(items, path+"/items", false, elements)
+	}
+	if additional, ok := schema["additionalProperties"]; ok {
+		flattenJsonNode(additional, path+"/additionalProperties", false, elements)
+	}
+	for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+		branches, _ := schema[keyword].([]any)
+		for i, branch := range branches {
+			flattenJsonNode(branch, fmt.Sprintf("%s/%s/%d", path, keyword, i), false, elements)
+		}
+	}
+}
+
+func describeJsonType(schema map[string]any) string {
+	if ref, ok := schema["$ref"].(string); ok {
+		return fmt.Sprintf(`$ref "%s"`, ref)
+	}
+
+	description := "any"
+	if types := getJsonTypes(schema); types != nil {
+		description = strings.Join(types, " | ")
+	}
+	if enum, ok := schema["enum"]; ok {
+		description += " enum " + marshalDefault(enum)
+	}
+	if constValue, ok := schema["const"]; ok {
+		description += " const " + marshalDefault(constValue)
+	}
+	return description
+}
+
+func marshalDefault(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
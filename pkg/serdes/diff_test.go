@@ -0,0 +1,90 @@
+package serdes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSchemasProtobuf(t *testing.T) {
+	v1 := `syntax = "proto3";
+package example;
+
+message Employee {
+  string name = 1;
+  int32 age = 2;
+  Team team = 3;
+}
+
+enum Team {
+  NONE = 0;
+  ENGINEERING = 1;
+}`
+	v2 := `syntax = "proto3";
+package example;
+
+message Employee {
+  string name = 1;
+  int64 age = 2;
+  repeated string emails = 4;
+}
+
+enum Team {
+  NONE = 0;
+  ENGINEERING = 1;
+  SALES = 2;
+}`
+	paths := writeSchemas(t, ".proto", v1, v2)
+
+	changes, err := DiffSchemas("protobuf", paths[0], map[string]string{}, paths[1], map[string]string{})
+	require.NoError(t, err)
+	require.Equal(t, []SchemaChange{
+		{Path: "Employee.age", Change: ChangeTypeChanged, Old: "int32 = 2", New: "int64 = 2"},
+		{Path: "Employee.emails", Change: ChangeAdded, New: "repeated string = 4"},
+		{Path: "Employee.team", Change: ChangeRemoved, Old: `enum "example.Team" = 3`},
+		{Path: "Team.SALES", Change: ChangeAdded, New: "value 2"},
+	}, changes)
+}
+
+func TestDiffSchemasJson(t *testing.T) {
+	v1 := `{"type":"object","properties":{"name":{"type":"string"},"age":{"type":"integer","default":0},"tags":{"type":"array","items":{"type":"string"}}}}`
+	v2 := `{"type":"object","properties":{"name":{"type":"string"},"age":{"type":["integer","null"],"default":18},"tags":{"type":"array","items":{"type":"integer"}}},"required":["name"]}`
+	paths := writeSchemas(t, ".json", v1, v2)
+
+	changes, err := DiffSchemas("json", paths[0], map[string]string{}, paths[1], map[string]string{})
+	require.NoError(t, err)
+	require.Equal(t, []SchemaChange{
+		{Path: "#/properties/age", Change: ChangeTypeChanged, Old: "integer", New: "integer | null"},
+		{Path: "#/properties/age", Change: ChangeDefaultChanged, Old: "0", New: "18"},
+		{Path: "#/properties/name", Change: ChangeTypeChanged, Old: "string", New: "string (required)"},
+		{Path: "#/properties/tags/items", Change: ChangeTypeChanged, Old: "string", New: "integer"},
+	}, changes)
+}
+
+func TestDiffSchemasAvroUnchanged(t *testing.T) {
+	schema := `{"type":"record","name":"Node","fields":[{"name":"next","type":["null","Node"],"default":null}]}`
+	paths := writeSchemas(t, ".avsc", schema, schema)
+
+	changes, err := DiffSchemas("avro", paths[0], map[string]string{}, paths[1], map[string]string{})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestDiffSchemasAvroReferences(t *testing.T) {
+	employee := `{"type":"record","name":"Employee","namespace":"example","fields":[{"name":"name","type":"string"},{"name":"address","type":"Address"}]}`
+	address1 := `{"type":"record","name":"Address","namespace":"example","fields":[{"name":"city","type":"string"},{"name":"country","type":"Country"}]}`
+	address2 := `{"type":"record","name":"Address","namespace":"example","fields":[{"name":"city","type":"string"},{"name":"zip","type":["null","string"],"default":null},{"name":"country","type":"Country"}]}`
+	country := `{"type":"enum","name":"Country","namespace":"example","symbols":["US","CA"]}`
+	oldPaths := writeSchemas(t, ".avsc", employee, address1, country)
+	newPaths := writeSchemas(t, ".avsc", employee, address2, country)
+
+	changes, err := DiffSchemas("avro",
+		oldPaths[0], map[string]string{"Address": oldPaths[1], "Country": oldPaths[2]},
+		newPaths[0], map[string]string{"Address": newPaths[1], "Country": newPaths[2]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []SchemaChange{{Path: "$.address.zip", Change: ChangeAdded, New: "union [null, string]"}}, changes)
+
+	_, err = DiffSchemas("avro", oldPaths[0], map[string]string{"Address": oldPaths[1]}, newPaths[0], map[string]string{})
+	require.Error(t, err)
+}
@@ -0,0 +1,411 @@
+package serdes
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// jsonUpperBounds lists the keywords which reject more values as they decrease.
+	jsonUpperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+
+	// jsonLowerBounds lists the keywords which reject more values as they increase.
+	jsonLowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+)
+
+func parseJsonSchemaFile(path string, referencePathMap map[string]string) (any, error) {
+	// Validate the schema with the same library used to serialize messages.
+	if _, err := parseSchema(path, referencePathMap); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// jsonCompatibilityChecker checks that every value accepted by the writer schema is also accepted by the reader schema.
+type jsonCompatibilityChecker struct{}
+
+func (c *jsonCompatibilityChecker) check(reader, writer any) []Incompatibility {
+	comparer := &jsonComparer{
+		readerRoot: reader,
+		writerRoot: writer,
+		visited:    make(map[[2]string]bool),
+	}
+	comparer.compare(reader, writer, "#")
+	return comparer.incompatibilities
+}
+
+type jsonComparer struct {
+	// readerRoot and writerRoot are the full schemas, against which local references such as "#/definitions/Address" are resolved.
+	readerRoot any
+	writerRoot any
+	// visited holds the pairs of reader and writer references being compared, so that recursive schemas are compared once.
+	visited           map[[2]string]bool
+	incompatibilities []Incompatibility
+}
+
+func (c *jsonComparer) report(path, format string, args ...any) {
+	c.incompatibilities = append(c.incompatibilities, Incompatibility{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+// matches reports whether the reader schema accepts every value of the writer schema, without reporting anything.
+func (c *jsonComparer) matches(reader, writer any) bool {
+	trial := &jsonComparer{
+		readerRoot: c.readerRoot,
+		writerRoot: c.writerRoot,
+		visited:    c.visited,
+	}
+	trial.compare(reader, writer, "")
+	return len(trial.incompatibilities) == 0
+}
+
+func (c *jsonComparer) compare(readerNode, writerNode any, path string) {
+	// A "false" schema accepts no values, and a "true" schema accepts every value.
+	if writerNode == false {
+		return
+	}
+	if readerNode == false {
+		c.report(path, "the reader schema rejects every value")
+		return
+	}
+	reader, _ := readerNode.(map[string]any)
+	writer, _ := writerNode.(map[string]any)
+
+	readerRef, _ := reader["$ref"].(string)
+	writerRef, _ := writer["$ref"].(string)
+	if isLocalJsonRef(readerRef) || isLocalJsonRef(writerRef) {
+		c.compareLocalRefs(readerNode, writerNode, readerRef, writerRef, path)
+		return
+	}
+	if readerRef != writerRef {
+		c.report(path, `the reference changed from "%s" to "%s"`, writerRef, readerRef)
+		return
+	}
+	if readerRef != "" {
+		return
+	}
+
+	c.compareTypes(reader, writer, path)
+	c.compareValues(reader, writer, path)
+	c.compareBounds(reader, writer, path)
+	c.compareCombinations(reader, writer, path)
+	c.compareAllOf(reader, writer, path)
+	c.compareProperties(reader, writer, path)
+
+	readerItems, readerHasItems := reader["items"]
+	writerItems, writerHasItems := writer["items"]
+	if readerHasItems || writerHasItems {
+		if !writerHasItems {
+			writerItems = true
+		}
+		if !readerHasItems {
+			readerItems = true
+		}
+		if !isJsonArray(readerItems) && !isJsonArray(writerItems) {
+			c.compare(readerItems, writerItems, path+"/items")
+		}
+	}
+}
+
+// compareLocalRefs compares the definitions of local references, so that changes to "definitions" and "$defs" are checked.
+// References to other schemas are compared by name.
+func (c *jsonComparer) compareLocalRefs(readerNode, writerNode any, readerRef, writerRef, path string) {
+	refs := [2]string{readerRef, writerRef}
+	if c.visited[refs] {
+		return
+	}
+	c.visited[refs] = true
+	defer delete(c.visited, refs)
+
+	if isLocalJsonRef(readerRef) {
+		resolved, ok := resolveJsonRef(c.readerRoot, readerRef)
+		if !ok {
+			c.report(path, `the reference "%s" cannot be resolved`, readerRef)
+			return
+		}
+		readerNode = resolved
+	}
+	if isLocalJsonRef(writerRef) {
+		resolved, ok := resolveJsonRef(c.writerRoot, writerRef)
+		if !ok {
+			c.report(path, `the reference "%s" of the previous schema cannot be resolved`, writerRef)
+			return
+		}
+		writerNode = resolved
+	}
+
+	c.compare(readerNode, writerNode, path)
+}
+
+func (c *jsonComparer) compareTypes(reader, writer map[string]any, path string) {
+	readerTypes := getJsonTypes(reader)
+	if readerTypes == nil {
+		return
+	}
+
+	writerTypes := getJsonTypes(writer)
+	if writerTypes == nil {
+		c.report(path, "the type was restricted to %s", strings.Join(readerTypes, ", "))
+		return
+	}
+
+	for _, typ := range writerTypes {
+		if !slices.Contains(readerTypes, typ) && !(typ == "integer" && slices.Contains(readerTypes, "number")) {
+			c.report(path, `the type "%s" was removed`, typ)
+		}
+	}
+}
+
+func (c *jsonComparer) compareValues(reader, writer map[string]any, path string) {
+	if readerEnum, ok := reader["enum"].([]any); ok {
+		writerEnum, ok := writer["enum"].([]any)
+		if !ok {
+			c.report(path, "an enum was added")
+		}
+		for _, value := range writerEnum {
+			if !slices.ContainsFunc(readerEnum, func(readerValue any) bool { return reflect.DeepEqual(readerValue, value) }) {
+				c.report(path, "the enum value %v was removed", value)
+			}
+		}
+	}
+
+	if readerConst, ok := reader["const"]; ok {
+		if writerConst, ok := writer["const"]; !ok || !reflect.DeepEqual(readerConst, writerConst) {
+			c.report(path, "the const value changed to %v", readerConst)
+		}
+	}
+
+	for _, keyword := range []string{"pattern", "multipleOf"} {
+		if readerValue, ok := reader[keyword]; ok && !reflect.DeepEqual(readerValue, writer[keyword]) {
+			c.report(path, `"%s" changed to %v`, keyword, readerValue)
+		}
+	}
+
+	if reader["uniqueItems"] == true && writer["uniqueItems"] != true {
+		c.report(path, `"uniqueItems" was added`)
+	}
+}
+
+func (c *jsonComparer) compareBounds(reader, writer map[string]any, path string) {
+	for _, keyword := range append(jsonUpperBounds, jsonLowerBounds...) {
+		readerValue, ok := reader[keyword].(float64)
+		if !ok {
+			continue
+		}
+		writerValue, ok := writer[keyword].(float64)
+		if !ok {
+			c.report(path, `"%s" was added`, keyword)
+			continue
+		}
+		if slices.Contains(jsonUpperBounds, keyword) && readerValue < writerValue {
+			c.report(path, `"%s" decreased from %v to %v`, keyword, writerValue, readerValue)
+		}
+		if slices.Contains(jsonLowerBounds, keyword) && readerValue > writerValue {
+			c.report(path, `"%s" increased from %v to %v`, keyword, writerValue, readerValue)
+		}
+	}
+}
+
+// compareCombinations checks that each branch of an "anyOf" or "oneOf" writer schema is accepted by a reader branch.
+func (c *jsonComparer) compareCombinations(reader, writer map[string]any, path string) {
+	readerKeyword, readerBranches := getJsonBranches(reader)
+	writerKeyword, writerBranches := getJsonBranches(writer)
+
+	switch {
+	case readerBranches != nil && writerBranches != nil:
+		for i, writerBranch := range writerBranches {
+			if !slices.ContainsFunc(readerBranches, func(readerBranch any) bool { return c.matches(readerBranch, writerBranch) }) {
+				c.report(fmt.Sprintf("%s/%s/%d", path, writerKeyword, i), `the branch was removed from "%s"`, readerKeyword)
+			}
+		}
+	case readerBranches != nil:
+		if !slices.ContainsFunc(readerBranches, func(readerBranch any) bool { return c.matches(readerBranch, writer) }) {
+			c.report(path, `no branch of "%s" accepts the previous schema`, readerKeyword)
+		}
+	case writerBranches != nil:
+		for i, writerBranch := range writerBranches {
+			c.compare(reader, writerBranch, fmt.Sprintf("%s/%s/%d", path, writerKeyword, i))
+		}
+	}
+}
+
+// compareAllOf checks that the writer schema is accepted by every "allOf" branch of the reader schema. A writer schema with
+// its own "allOf" branches satisfies a reader branch if one of its branches, or the rest of the writer schema, does.
+func (c *jsonComparer) compareAllOf(reader, writer map[string]any, path string) {
+	readerBranches, _ := reader["allOf"].([]any)
+	writerBranches, _ := writer["allOf"].([]any)
+
+	for i, readerBranch := range readerBranches {
+		branchPath := fmt.Sprintf("%s/allOf/%d", path, i)
+		switch {
+		case writerBranches == nil:
+			c.compare(readerBranch, writer, branchPath)
+		case c.matches(readerBranch, withoutJsonKeyword(writer, "allOf")):
+		case slices.ContainsFunc(writerBranches, func(writerBranch any) bool { return c.matches(readerBranch, writerBranch) }):
+		case i < len(writerBranches):
+			c.compare(readerBranch, writerBranches[i], branchPath)
+		default:
+			c.report(branchPath, `the branch was added to "allOf"`)
+		}
+	}
+}
+
+func (c *jsonComparer) compareProperties(reader, writer map[string]any, path string) {
+	readerProperties, _ := reader["properties"].(map[string]any)
+	writerProperties, _ := writer["properties"].(map[string]any)
+	readerAdditional := getJsonAdditionalProperties(reader)
+	writerAdditional := getJsonAdditionalProperties(writer)
+
+	for _, name := range sortedKeys(writerProperties) {
+		propertyPath := fmt.Sprintf("%s/properties/%s", path, name)
+		if readerProperty, ok := readerProperties[name]; ok {
+			c.compare(readerProperty, writerProperties[name], propertyPath)
+		} else if readerAdditional == false {
+			c.report(propertyPath, `the property "%s" was removed from a closed content model`, name)
+		} else if !c.matches(readerAdditional, writerProperties[name]) {
+			c.report(propertyPath, `the property "%s" was removed and is not accepted by "additionalProperties"`, name)
+		}
+	}
+
+	for _, name := range sortedKeys(readerProperties) {
+		if _, ok := writerProperties[name]; !ok && writerAdditional != false && !c.matches(readerProperties[name], writerAdditional) {
+			c.report(fmt.Sprintf("%s/properties/%s", path, name), `the property "%s" was added to an open content model`, name)
+		}
+	}
+
+	writerRequired := getJsonRequired(writer)
+	for _, name := range getJsonRequired(reader) {
+		if slices.Contains(writerRequired, name) {
+			continue
+		}
+		propertyPath := fmt.Sprintf("%s/properties/%s", path, name)
+		if _, ok := writerProperties[name]; ok {
+			c.report(propertyPath, `the property "%s" became required`, name)
+		} else if property, _ := readerProperties[name].(map[string]any); property["default"] == nil {
+			c.report(propertyPath, `the required property "%s" was added without a default`, name)
+		}
+	}
+
+	if readerAdditional == false && writerAdditional != false {
+		c.report(path, `"additionalProperties" became false`)
+	} else if _, ok := reader["additionalProperties"].(map[string]any); ok {
+		c.compare(readerAdditional, writerAdditional, path+"/additionalProperties")
+	}
+}
+
+func getJsonTypes(schema map[string]any) []string {
+	switch typ := schema["type"].(type) {
+	case string:
+		return []string{typ}
+	case []any:
+		types := make([]string, 0, len(typ))
+		for _, t := range typ {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+		return types
+	default:
+		return nil
+	}
+}
+
+func getJsonBranches(schema map[string]any) (string, []any) {
+	for _, keyword := range []string{"anyOf", "oneOf"} {
+		if branches, ok := schema[keyword].([]any); ok {
+			return keyword, branches
+		}
+	}
+	return "", nil
+}
+
+// getJsonAdditionalProperties returns the schema of properties which are not listed, which accepts every value by default.
+func getJsonAdditionalProperties(schema map[string]any) any {
+	if additional, ok := schema["additionalProperties"]; ok {
+		return additional
+	}
+	return true
+}
+
+func getJsonRequired(schema map[string]any) []string {
+	required, _ := schema["required"].([]any)
+	names := make([]string, 0, len(required))
+	for _, name := range required {
+		if name, ok := name.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func isLocalJsonRef(ref string) bool {
+	return strings.HasPrefix(ref, "#")
+}
+
+// resolveJsonRef returns the node of a schema which a local reference, formatted as a JSON pointer, points to.
+func resolveJsonRef(root any, ref string) (any, bool) {
+	node := root
+	pointer := strings.TrimPrefix(ref, "#")
+	if pointer == "" {
+		return node, true
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, false
+			}
+			node = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, false
+			}
+			node = n[i]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+func withoutJsonKeyword(schema map[string]any, keyword string) map[string]any {
+	copied := make(map[string]any, len(schema))
+	for key, value := range schema {
+		if key != keyword {
+			copied[key] = value
+		}
+	}
+	return copied
+}
+
+func isJsonArray(node any) bool {
+	_, ok := node.([]any)
+	return ok
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -13,6 +13,7 @@ import (
 
 type JsonSerializationProvider struct {
 	schemaLoader *gojsonschema.Schema
+	title        string
 }
 
 func (j *JsonSerializationProvider) LoadSchema(schemaPath string, referencePathMap map[string]string) error {
@@ -21,9 +22,23 @@ func (j *JsonSerializationProvider) LoadSchema(schemaPath string, referencePathM
 		return err
 	}
 	j.schemaLoader = schemaLoader
+
+	title, err := readSchemaTitle(schemaPath)
+	if err != nil {
+		return err
+	}
+	j.title = title
 	return nil
 }
 
+// GetRecordName returns the title of the schema.
+func (j *JsonSerializationProvider) GetRecordName() (string, error) {
+	if j.title == "" {
+		return "", fmt.Errorf(`the JSON schema does not have a "title"`)
+	}
+	return j.title, nil
+}
+
 func (j *JsonSerializationProvider) GetSchemaName() string {
 	return jsonSchemaBackendName
 }
@@ -71,3 +86,19 @@ func parseSchema(schemaPath string, referencePathMap map[string]string) (*gojson
 
 	return sl.Compile(gojsonschema.NewStringLoader(string(schema)))
 }
+
+func readSchemaTitle(schemaPath string) (string, error) {
+	schema, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return "", err
+	}
+
+	var properties struct {
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(schema, &properties); err != nil {
+		// A boolean schema has no title.
+		return "", nil
+	}
+	return properties.Title, nil
+}
@@ -0,0 +1,190 @@
+package serdes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// protobufScalarGroups lists the groups of scalar types which share a wire format, so that a field may change between
+// types of the same group.
+var protobufScalarGroups = [][]string{
+	{"int32", "uint32", "int64", "uint64", "bool"},
+	{"sint32", "sint64"},
+	{"fixed32", "sfixed32"},
+	{"fixed64", "sfixed64"},
+	{"string", "bytes"},
+}
+
+func parseProtobufSchemaFile(path string, referencePathMap map[string]string) (any, error) {
+	return parseFileDescriptor(path, referencePathMap)
+}
+
+// protobufCompatibilityChecker follows the rules of Schema Registry for Protobuf, which compare the messages and fields
+// of the writer schema with those of the reader schema by name and field number.
+type protobufCompatibilityChecker struct{}
+
+func (c *protobufCompatibilityChecker) check(reader, writer any) []Incompatibility {
+	differ := new(protobufDiffer)
+	differ.diffFiles(reader.(*desc.FileDescriptor), writer.(*desc.FileDescriptor))
+	return differ.incompatibilities
+}
+
+type protobufDiffer struct {
+	incompatibilities []Incompatibility
+}
+
+func (d *protobufDiffer) report(path, format string, args ...any) {
+	d.incompatibilities = append(d.incompatibilities, Incompatibility{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+func (d *protobufDiffer) diffFiles(reader, writer *desc.FileDescriptor) {
+	if reader.GetPackage() != writer.GetPackage() {
+		d.report(writer.GetPackage(), `the package changed from "%s" to "%s"`, writer.GetPackage(), reader.GetPackage())
+	}
+
+	readerMessages := make(map[string]*desc.MessageDescriptor)
+	for _, message := range getAllMessageTypes(reader.GetMessageTypes()) {
+		readerMessages[relativeName(message, reader)] = message
+	}
+
+	for _, writerMessage := range getAllMessageTypes(writer.GetMessageTypes()) {
+		name := relativeName(writerMessage, writer)
+		readerMessage, ok := readerMessages[name]
+		if !ok {
+			d.report(name, `the message "%s" was removed`, name)
+			continue
+		}
+		d.diffMessages(readerMessage, writerMessage, name)
+	}
+}
+
+func (d *protobufDiffer) diffMessages(reader, writer *desc.MessageDescriptor, path string) {
+	movedToNewOneOf := make(map[string]int)
+
+	for _, writerField := range writer.GetFields() {
+		fieldPath := fmt.Sprintf("%s.%s", path, writerField.GetName())
+		writerOneOf := getOneOf(writerField)
+
+		readerField := reader.FindFieldByNumber(writerField.GetNumber())
+		if readerField == nil {
+			if writerField.IsRequired() {
+				d.report(fieldPath, `the required field "%s" was removed`, writerField.GetName())
+			} else if writerOneOf != nil {
+				d.report(fieldPath, `the field "%s" was removed from oneof "%s"`, writerField.GetName(), writerOneOf.GetName())
+			}
+			continue
+		}
+
+		d.diffFields(readerField, writerField, fieldPath)
+
+		if readerOneOf := getOneOf(readerField); readerOneOf != nil && writerOneOf == nil {
+			if slices.ContainsFunc(writer.GetOneOfs(), func(oneOf *desc.OneOfDescriptor) bool { return oneOf.GetName() == readerOneOf.GetName() }) {
+				d.report(fieldPath, `the field "%s" was moved into the existing oneof "%s"`, writerField.GetName(), readerOneOf.GetName())
+			} else {
+				movedToNewOneOf[readerOneOf.GetName()]++
+			}
+		}
+	}
+
+	for _, oneOf := range reader.GetOneOfs() {
+		if movedToNewOneOf[oneOf.GetName()] > 1 {
+			d.report(fmt.Sprintf("%s.%s", path, oneOf.GetName()), `multiple existing fields were moved into the new oneof "%s"`, oneOf.GetName())
+		}
+	}
+
+	for _, readerField := range reader.GetFields() {
+		if readerField.IsRequired() && writer.FindFieldByNumber(readerField.GetNumber()) == nil {
+			d.report(fmt.Sprintf("%s.%s", path, readerField.GetName()), `the required field "%s" was added`, readerField.GetName())
+		}
+	}
+}
+
+func (d *protobufDiffer) diffFields(reader, writer *desc.FieldDescriptor, path string) {
+	readerKind, writerKind := getFieldKind(reader), getFieldKind(writer)
+	if readerKind != writerKind {
+		d.report(path, `the field "%s" changed from %s to %s`, writer.GetName(), describeFieldType(writer), describeFieldType(reader))
+		return
+	}
+
+	switch writerKind {
+	case "scalar":
+		readerType, writerType := getScalarType(reader), getScalarType(writer)
+		if readerType != writerType && !slices.ContainsFunc(protobufScalarGroups, func(group []string) bool {
+			return slices.Contains(group, readerType) && slices.Contains(group, writerType)
+		}) {
+			d.report(path, `the field "%s" changed from %s to %s`, writer.GetName(), writerType, readerType)
+		}
+	case "message", "enum":
+		if relativeName(getNamedType(reader), reader.GetFile()) != relativeName(getNamedType(writer), writer.GetFile()) {
+			d.report(path, `the field "%s" changed from %s to %s`, writer.GetName(), describeFieldType(writer), describeFieldType(reader))
+		}
+	}
+
+	if reader.IsRequired() && !writer.IsRequired() {
+		d.report(path, `the field "%s" became required`, writer.GetName())
+	}
+}
+
+func getAllMessageTypes(messages []*desc.MessageDescriptor) []*desc.MessageDescriptor {
+	var all []*desc.MessageDescriptor
+	for _, message := range messages {
+		if message.IsMapEntry() {
+			continue
+		}
+		all = append(all, message)
+		all = append(all, getAllMessageTypes(message.GetNestedMessageTypes())...)
+	}
+	return all
+}
+
+// relativeName returns the name of a type within its package, so that a changed package is only reported once.
+func relativeName(descriptor desc.Descriptor, file *desc.FileDescriptor) string {
+	if pkg := file.GetPackage(); pkg != "" {
+		return strings.TrimPrefix(descriptor.GetFullyQualifiedName(), pkg+".")
+	}
+	return descriptor.GetFullyQualifiedName()
+}
+
+// getOneOf returns the oneof of a field, ignoring the synthetic oneofs of proto3 optional fields.
+func getOneOf(field *desc.FieldDescriptor) *desc.OneOfDescriptor {
+	if oneOf := field.GetOneOf(); oneOf != nil && !oneOf.IsSynthetic() {
+		return oneOf
+	}
+	return nil
+}
+
+func getFieldKind(field *desc.FieldDescriptor) string {
+	switch {
+	case field.GetMessageType() != nil:
+		return "message"
+	case field.GetEnumType() != nil:
+		return "enum"
+	default:
+		return "scalar"
+	}
+}
+
+func getScalarType(field *desc.FieldDescriptor) string {
+	return strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_"))
+}
+
+func getNamedType(field *desc.FieldDescriptor) desc.Descriptor {
+	if message := field.GetMessageType(); message != nil {
+		return message
+	}
+	return field.GetEnumType()
+}
+
+func describeFieldType(field *desc.FieldDescriptor) string {
+	switch getFieldKind(field) {
+	case "message":
+		return fmt.Sprintf(`message "%s"`, field.GetMessageType().GetFullyQualifiedName())
+	case "enum":
+		return fmt.Sprintf(`enum "%s"`, field.GetEnumType().GetFullyQualifiedName())
+	default:
+		return getScalarType(field)
+	}
+}
@@ -0,0 +1,97 @@
+package serdes
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/jhump/protoreflect/desc"
+)
+
+// The message indexes identify which message type of a Protobuf schema a record was serialized with. They are the path
+// to the message type from the top level of the schema: the index of the outermost message type, followed by the
+// index of each nested message type. On the wire, they are written after the schema ID as a zigzag varint count
+// followed by one zigzag varint per index, except for the common case [0], which is written as a single 0 byte.
+
+func getMessageIndexes(messageDescriptor *desc.MessageDescriptor) []int {
+	var indexes []int
+	for {
+		var siblings []*desc.MessageDescriptor
+		parent := messageDescriptor.GetParent()
+		switch parent := parent.(type) {
+		case *desc.MessageDescriptor:
+			siblings = parent.GetNestedMessageTypes()
+		case *desc.FileDescriptor:
+			siblings = parent.GetMessageTypes()
+		}
+
+		for i, sibling := range siblings {
+			if sibling == messageDescriptor {
+				indexes = append([]int{i}, indexes...)
+				break
+			}
+		}
+
+		next, ok := parent.(*desc.MessageDescriptor)
+		if !ok {
+			return indexes
+		}
+		messageDescriptor = next
+	}
+}
+
+func getMessageDescriptor(fileDescriptor *desc.FileDescriptor, indexes []int) (*desc.MessageDescriptor, error) {
+	messageDescriptors := fileDescriptor.GetMessageTypes()
+
+	var messageDescriptor *desc.MessageDescriptor
+	for _, index := range indexes {
+		if index < 0 || index >= len(messageDescriptors) {
+			return nil, fmt.Errorf("invalid message indexes %v for the protobuf schema", indexes)
+		}
+		messageDescriptor = messageDescriptors[index]
+		messageDescriptors = messageDescriptor.GetNestedMessageTypes()
+	}
+
+	if messageDescriptor == nil {
+		return nil, fmt.Errorf("invalid message indexes %v for the protobuf schema", indexes)
+	}
+	return messageDescriptor, nil
+}
+
+func writeMessageIndexes(indexes []int) []byte {
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0}
+	}
+
+	data := binary.AppendVarint(nil, int64(len(indexes)))
+	for _, index := range indexes {
+		data = binary.AppendVarint(data, int64(index))
+	}
+	return data
+}
+
+// readMessageIndexes returns the message indexes at the start of the data, and the rest of the data.
+func readMessageIndexes(data []byte) ([]int, []byte, error) {
+	count, n := binary.Varint(data)
+	if n <= 0 || count < 0 {
+		return nil, nil, fmt.Errorf("failed to read the message indexes of the protobuf message")
+	}
+	data = data[n:]
+
+	if count == 0 {
+		return []int{0}, data, nil
+	}
+	if count > int64(len(data)) {
+		return nil, nil, fmt.Errorf("failed to read the message indexes of the protobuf message")
+	}
+
+	indexes := make([]int, count)
+	for i := range indexes {
+		index, n := binary.Varint(data)
+		if n <= 0 {
+			return nil, nil, fmt.Errorf("failed to read the message indexes of the protobuf message")
+		}
+		indexes[i] = int(index)
+		data = data[n:]
+	}
+	return indexes, data, nil
+}
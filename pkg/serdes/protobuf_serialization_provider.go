@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/protobuf/jsonpb" //nolint:staticcheck // deprecated module cannot be removed due to https://github.com/jhump/protoreflect/issues/301
 	"github.com/golang/protobuf/proto"  //nolint:staticcheck // deprecated module cannot be removed due to https://github.com/jhump/protoreflect/issues/301
+	"github.com/jhump/protoreflect/desc"
 	parse "github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/jhump/protoreflect/dynamic"
 
@@ -14,26 +15,47 @@ import (
 )
 
 type ProtobufSerializationProvider struct {
-	message proto.Message
+	messageType string
+	recordName  string
+	message     proto.Message
+	indexes     []int
+}
+
+// SetMessageType selects the message type to serialize by its fully-qualified name, such as "my.package.Outer.Inner".
+// It must be called before LoadSchema. By default, the first message type of the schema is used.
+func (p *ProtobufSerializationProvider) SetMessageType(messageType string) {
+	p.messageType = messageType
 }
 
 func (p *ProtobufSerializationProvider) LoadSchema(schemaPath string, referencePathMap map[string]string) error {
-	message, err := parseMessage(schemaPath, referencePathMap)
+	fileDescriptor, err := parseFileDescriptor(schemaPath, referencePathMap)
 	if err != nil {
 		return err
 	}
-	p.message = message
+
+	messageDescriptor, err := findMessageDescriptor(fileDescriptor, p.messageType)
+	if err != nil {
+		return err
+	}
+
+	p.message = dynamic.NewMessageFactoryWithDefaults().NewMessage(messageDescriptor)
+	p.recordName = messageDescriptor.GetFullyQualifiedName()
+	p.indexes = getMessageIndexes(messageDescriptor)
 	return nil
 }
 
+// GetRecordName returns the fully-qualified name of the selected message type.
+func (p *ProtobufSerializationProvider) GetRecordName() (string, error) {
+	return p.recordName, nil
+}
+
 func (p *ProtobufSerializationProvider) GetSchemaName() string {
 	return protobufSchemaBackendName
 }
 
 func (p *ProtobufSerializationProvider) Serialize(str string) ([]byte, error) {
 	// Index array indicates which message in the file we're referring to.
-	// In our case, index array is always [0].
-	indexBytes := []byte{0x0}
+	indexBytes := writeMessageIndexes(p.indexes)
 
 	// Convert from JSON string to proto message type.
 	if err := jsonpb.UnmarshalString(str, p.message); err != nil {
@@ -49,7 +71,7 @@ func (p *ProtobufSerializationProvider) Serialize(str string) ([]byte, error) {
 	return data, nil
 }
 
-func parseMessage(schemaPath string, referencePathMap map[string]string) (proto.Message, error) {
+func parseFileDescriptor(schemaPath string, referencePathMap map[string]string) (*desc.FileDescriptor, error) {
 	importPaths := []string{filepath.Dir(schemaPath)}
 	for _, path := range referencePathMap {
 		importPaths = append(importPaths, strings.SplitAfter(path, "ccloud-schema")[0])
@@ -59,17 +81,22 @@ func parseMessage(schemaPath string, referencePathMap map[string]string) (proto.
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", errors.ProtoSchemaInvalidErrorMsg, err)
 	}
-	if len(fileDescriptors) == 0 {
+	if len(fileDescriptors) == 0 || len(fileDescriptors[0].GetMessageTypes()) == 0 {
 		return nil, fmt.Errorf(errors.ProtoSchemaInvalidErrorMsg)
 	}
-	fileDescriptor := fileDescriptors[0]
+	return fileDescriptors[0], nil
+}
+
+// findMessageDescriptor returns the message type with the given fully-qualified name, or the first message type of
+// the schema if the name is empty.
+func findMessageDescriptor(fileDescriptor *desc.FileDescriptor, messageType string) (*desc.MessageDescriptor, error) {
+	if messageType == "" {
+		return fileDescriptor.GetMessageTypes()[0], nil
+	}
 
-	messageDescriptors := fileDescriptor.GetMessageTypes()
-	if len(messageDescriptors) == 0 {
-		return nil, fmt.Errorf(errors.ProtoSchemaInvalidErrorMsg)
+	messageDescriptor := fileDescriptor.FindMessage(strings.TrimPrefix(messageType, "."))
+	if messageDescriptor == nil {
+		return nil, fmt.Errorf(`message type "%s" is not defined in the protobuf schema`, messageType)
 	}
-	// We're always using the outermost first message.
-	messageDescriptor := messageDescriptors[0]
-	messageFactory := dynamic.NewMessageFactoryWithDefaults()
-	return messageFactory.NewMessage(messageDescriptor), nil
+	return messageDescriptor, nil
 }
@@ -47,6 +47,13 @@ type DeserializationProvider interface {
 	Deserialize([]byte) (string, error)
 }
 
+// RecordNameProvider is implemented by the serialization providers of schema-based formats. GetRecordName returns the
+// fully-qualified name of the record defined by the loaded schema, as used by the RecordName and TopicRecordName
+// subject name strategies.
+type RecordNameProvider interface {
+	GetRecordName() (string, error)
+}
+
 func FormatTranslation(backendValueFormat string) (string, error) {
 	var cliValueFormat string
 	switch backendValueFormat {
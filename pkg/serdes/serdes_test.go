@@ -421,8 +421,127 @@ func TestProtobufSerdesNestedValid(t *testing.T) {
 	req.NoError(os.RemoveAll(dir))
 }
 
+func TestProtobufSerdesMessageType(t *testing.T) {
+	req := require.New(t)
+
+	dir, err := createTempDir()
+	req.Nil(err)
+
+	schemaString := `
+	syntax = "proto3";
+	package io.confluent;
+	message Person {
+	  string name = 1;
+	}
+	message Order {
+	  int32 id = 1;
+	  message Item {
+	    string sku = 1;
+	  }
+	}`
+	schemaPath := filepath.Join(dir, "order.proto")
+	req.NoError(os.WriteFile(schemaPath, []byte(schemaString), 0644))
+
+	expectedString := `{"sku":"abc"}`
+	expectedBytes := []byte{4, 2, 0, 10, 3, 97, 98, 99}
+
+	serializationProvider := new(ProtobufSerializationProvider)
+	serializationProvider.SetMessageType("io.confluent.Order.Item")
+	req.NoError(serializationProvider.LoadSchema(schemaPath, map[string]string{}))
+	data, err := serializationProvider.Serialize(expectedString)
+	req.NoError(err)
+	req.Equal(expectedBytes, data)
+
+	deserializationProvider, _ := GetDeserializationProvider(protobufSchemaName)
+	req.NoError(deserializationProvider.LoadSchema(schemaPath, map[string]string{}))
+	str, err := deserializationProvider.Deserialize(data)
+	req.NoError(err)
+	req.Equal(expectedString, str)
+
+	serializationProvider.SetMessageType("io.confluent.Order")
+	req.NoError(serializationProvider.LoadSchema(schemaPath, map[string]string{}))
+	data, err = serializationProvider.Serialize(`{"id":5}`)
+	req.NoError(err)
+	req.Equal([]byte{2, 2, 8, 5}, data)
+
+	serializationProvider.SetMessageType("io.confluent.Missing")
+	req.EqualError(serializationProvider.LoadSchema(schemaPath, map[string]string{}), `message type "io.confluent.Missing" is not defined in the protobuf schema`)
+
+	_, err = deserializationProvider.Deserialize([]byte{2, 6, 10, 3, 97, 98, 99})
+	req.Error(err)
+
+	req.NoError(os.RemoveAll(dir))
+}
+
+func TestProtobufMessageIndexes(t *testing.T) {
+	req := require.New(t)
+
+	for _, indexes := range [][]int{{0}, {1}, {0, 0}, {2, 1, 3}, {70}} {
+		data := append(writeMessageIndexes(indexes), 42)
+		read, rest, err := readMessageIndexes(data)
+		req.NoError(err)
+		req.Equal(indexes, read)
+		req.Equal([]byte{42}, rest)
+	}
+
+	req.Equal([]byte{0}, writeMessageIndexes([]int{0}))
+
+	_, _, err := readMessageIndexes([]byte{})
+	req.Error(err)
+	_, _, err = readMessageIndexes([]byte{100, 2})
+	req.Error(err)
+}
+
 func createTempDir() (string, error) {
 	dir := filepath.Join(os.TempDir(), "ccloud-schema")
 	err := os.MkdirAll(dir, 0755)
 	return dir, err
 }
+
+func TestSerdesRecordName(t *testing.T) {
+	req := require.New(t)
+
+	dir, err := createTempDir()
+	req.Nil(err)
+
+	schemas := []struct {
+		format   string
+		filename string
+		schema   string
+		expected string
+	}{
+		{avroSchemaName, "record.avsc", `{"type":"record","name":"Order","namespace":"io.confluent","fields":[{"name":"id","type":"int"}]}`, "io.confluent.Order"},
+		{avroSchemaName, "qualified.avsc", `{"type":"record","name":"io.confluent.Order","fields":[{"name":"id","type":"int"}]}`, "io.confluent.Order"},
+		{avroSchemaName, "primitive.avsc", `"string"`, "string"},
+		{jsonSchemaName, "order.json", `{"title":"Order","type":"object","properties":{"id":{"type":"integer"}}}`, "Order"},
+		{protobufSchemaName, "order.proto", `syntax = "proto3"; package io.confluent; message Order { int32 id = 1; }`, "io.confluent.Order"},
+	}
+
+	for _, schema := range schemas {
+		schemaPath := filepath.Join(dir, schema.filename)
+		req.NoError(os.WriteFile(schemaPath, []byte(schema.schema), 0644))
+
+		serializationProvider, err := GetSerializationProvider(schema.format)
+		req.NoError(err)
+		req.NoError(serializationProvider.LoadSchema(schemaPath, map[string]string{}))
+
+		recordNameProvider, ok := serializationProvider.(RecordNameProvider)
+		req.True(ok)
+		name, err := recordNameProvider.GetRecordName()
+		req.NoError(err)
+		req.Equal(schema.expected, name)
+	}
+
+	schemaPath := filepath.Join(dir, "untitled.json")
+	req.NoError(os.WriteFile(schemaPath, []byte(`{"type":"object"}`), 0644))
+	serializationProvider := new(JsonSerializationProvider)
+	req.NoError(serializationProvider.LoadSchema(schemaPath, map[string]string{}))
+	_, err = serializationProvider.GetRecordName()
+	req.Error(err)
+
+	stringSerializationProvider, _ := GetSerializationProvider(stringSchemaName)
+	_, ok := stringSerializationProvider.(RecordNameProvider)
+	req.False(ok)
+
+	req.NoError(os.RemoveAll(dir))
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/stretchr/testify/require"
+
+	testserver "github.com/confluentinc/cli/v3/test/test-server"
 )
 
 func (s *CLITestSuite) TestConnect() {
@@ -27,6 +30,19 @@ func (s *CLITestSuite) TestConnect() {
 		{args: "connect cluster list --cluster lkc-123 -o yaml", fixture: "connect/cluster/list-yaml.golden"},
 		{args: "connect cluster list --cluster lkc-123", fixture: "connect/cluster/list.golden"},
 		{args: "connect cluster update lcc-123 --cluster lkc-123 --config-file test/fixtures/input/connect/config.yaml", fixture: "connect/cluster/update.golden"},
+		{args: "connect cluster describe lcc-123 --cluster lkc-123 --watch", fixture: "connect/cluster/describe-watch.golden"},
+		{args: "connect cluster describe lcc-123 --cluster lkc-123 --watch --target-state paused --timeout 1s", fixture: "connect/cluster/describe-watch-timeout.golden", exitCode: 1},
+		{args: "connect cluster restart lcc-123 lcc-111 --cluster lkc-123", fixture: "connect/cluster/restart.golden"},
+		{args: "connect cluster restart lcc-123 --cluster lkc-123 --include-tasks --failed-only", fixture: "connect/cluster/restart-failed-tasks.golden"},
+		{args: "connect cluster restart lcc-999 --cluster lkc-123", fixture: "connect/cluster/restart-unknown.golden", exitCode: 1},
+		{args: "connect cluster offset describe lcc-123 --cluster lkc-123", fixture: "connect/cluster/offset/describe.golden"},
+		{args: "connect cluster offset describe lcc-123 --cluster lkc-123 -o json", fixture: "connect/cluster/offset/describe-json.golden"},
+		{args: "connect cluster offset alter lcc-123 --cluster lkc-123 --offsets-file test/fixtures/input/connect/offsets.json", fixture: "connect/cluster/offset/alter.golden"},
+		{args: "connect cluster offset reset lcc-123 --cluster lkc-123 --force", fixture: "connect/cluster/offset/reset.golden"},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config-valid.json", fixture: "connect/cluster/validate.golden"},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json", fixture: "connect/cluster/validate-invalid.golden", exitCode: 1},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config-invalid.json -o json", fixture: "connect/cluster/validate-invalid-json.golden", exitCode: 1},
+		{args: "connect cluster validate --cluster lkc-123 --config-file test/fixtures/input/connect/config-malformed-old.json", fixture: "connect/cluster/validate-malformed.golden", exitCode: 1},
 		{args: "connect event describe", fixture: "connect/event-describe.golden"},
 
 		// Tests based on new config
@@ -84,6 +100,30 @@ func (s *CLITestSuite) TestConnectPlugin() {
 	}
 }
 
+func (s *CLITestSuite) TestConnectClusterOnPrem() {
+	url := s.TestBackend.GetConnectRestUrl()
+
+	tests := []CLITest{
+		{args: fmt.Sprintf("connect cluster create --config-file test/fixtures/input/connect/config-onprem.json --url %s", url), fixture: "connect/cluster/create-onprem.golden"},
+		{args: fmt.Sprintf("connect cluster create --config-file test/fixtures/input/connect/config-onprem-existing.json --url %s", url), fixture: "connect/cluster/create-onprem-existing.golden", exitCode: 1},
+		{args: "connect cluster create --config-file test/fixtures/input/connect/config-onprem.json", fixture: "connect/cluster/create-onprem-no-url.golden", exitCode: 1},
+		{args: fmt.Sprintf("connect cluster describe my-connector --url %s", url), fixture: "connect/cluster/describe-onprem.golden"},
+		{args: fmt.Sprintf("connect cluster describe my-connector --url %s -o json", url), fixture: "connect/cluster/describe-onprem-json.golden"},
+		{args: fmt.Sprintf("connect cluster describe unknown-connector --url %s", url), fixture: "connect/cluster/describe-onprem-unknown.golden", exitCode: 1},
+		{args: fmt.Sprintf("connect cluster update my-connector --config tasks.max=3 --url %s", url), fixture: "connect/cluster/update-onprem.golden"},
+		{args: fmt.Sprintf("connect cluster delete my-connector --force --url %s", url), fixture: "connect/cluster/delete-onprem.golden"},
+		{args: fmt.Sprintf("connect cluster delete my-connector --url %s", url), input: "my-connector\n", fixture: "connect/cluster/delete-onprem-prompt.golden"},
+		{args: fmt.Sprintf("connect cluster pause my-connector --url %s", url), fixture: "connect/cluster/pause-onprem.golden"},
+		{args: fmt.Sprintf("connect cluster resume my-connector --url %s", url), fixture: "connect/cluster/resume-onprem.golden"},
+		{args: fmt.Sprintf("connect cluster resume unknown-connector --url %s", url), fixture: "connect/cluster/resume-onprem-unknown.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		test.login = "onprem"
+		s.runIntegrationTest(test)
+	}
+}
+
 func (s *CLITestSuite) TestConnectPluginInstall() {
 	s.zipManifest()
 	defer s.deleteZip()
@@ -134,6 +174,70 @@ func (s *CLITestSuite) TestConnectPluginInstall() {
 	}
 }
 
+func (s *CLITestSuite) TestConnectPluginInstalled() {
+	confluentHome := "test/fixtures/input/connect/confluent-installed"
+	env := []string{"CONFLUENT_HOME=" + confluentHome}
+
+	tests := []CLITest{
+		{args: "connect plugin list --installed", env: env, fixture: "connect/plugin/list-installed.golden"},
+		{args: "connect plugin list --installed -o json", env: env, fixture: "connect/plugin/list-installed-json.golden"},
+		{args: fmt.Sprintf("connect plugin list --installed --confluent-platform %s", confluentHome), fixture: "connect/plugin/list-installed-platform-flag.golden"},
+		{args: "connect plugin list", env: env, fixture: "connect/plugin/list-installed-missing-flag.golden", exitCode: 1},
+
+		{args: "connect plugin uninstall acme/file-connector --dry-run --force", env: env, fixture: "connect/plugin/uninstall/force.golden"},
+		{args: "connect plugin uninstall confluentinc/integration-test-plugin --dry-run", env: env, input: "y\n", fixture: "connect/plugin/uninstall/interactive.golden"},
+		{args: fmt.Sprintf("connect plugin uninstall acme/file-connector --dry-run --force --worker-configurations %s/etc/kafka/connect-standalone.properties", confluentHome), env: env, fixture: "connect/plugin/uninstall/worker-configurations-flag.golden"},
+		{args: "connect plugin uninstall confluentinc/dne-connector --force", env: env, fixture: "connect/plugin/uninstall/not-installed.golden", exitCode: 1},
+
+		{args: "connect plugin upgrade --dry-run --force", env: env, fixture: "connect/plugin/upgrade/force.golden"},
+		{args: "connect plugin upgrade confluentinc/integration-test-plugin --dry-run", env: env, fixture: "connect/plugin/upgrade/dry-run.golden"},
+		{args: "connect plugin upgrade confluentinc/integration-test-plugin", env: env, input: "n\n", fixture: "connect/plugin/upgrade/interactive.golden"},
+		{args: "connect plugin upgrade --dry-run -o json", env: env, fixture: "connect/plugin/upgrade/dry-run-json.golden"},
+		{args: "connect plugin upgrade -o json", env: env, fixture: "connect/plugin/upgrade/json-without-force.golden", exitCode: 1},
+		{args: "connect plugin upgrade confluentinc/kafka-connect-jdbc", env: env, fixture: "connect/plugin/upgrade/up-to-date.golden"},
+		{args: fmt.Sprintf("connect plugin upgrade confluentinc/kafka-connect-jdbc --hub-url %s", testserver.TestHubUrl.String()), env: env, fixture: "connect/plugin/upgrade/up-to-date.golden"},
+		{args: "connect plugin upgrade --dry-run --force --hub-mirror test/fixtures/input/connect/hub-mirror", env: env, fixture: "connect/plugin/upgrade/hub-mirror.golden"},
+	}
+
+	for _, test := range tests {
+		test.login = "onprem"
+		s.runIntegrationTest(test)
+	}
+}
+
+func (s *CLITestSuite) TestConnectPluginMirror() {
+	s.zipManifest()
+	defer s.deleteZip()
+
+	mirrorDir := s.T().TempDir()
+	pluginDir := s.T().TempDir()
+	confluentHome733 := "test/fixtures/input/connect/confluent-7.3.3"
+	confluentHomeEmpty := "test/fixtures/input/connect/confluent-empty"
+
+	tests := []CLITest{
+		{args: fmt.Sprintf("connect plugin mirror sync confluentinc/integration-test-plugin confluentinc/integration-test-plugin:0.0.5 --dir %s", mirrorDir), fixture: "connect/plugin/mirror/sync.golden"},
+		{args: fmt.Sprintf("connect plugin mirror sync confluentinc/integration-test-plugin --dir %s -o json", mirrorDir), fixture: "connect/plugin/mirror/sync-up-to-date-json.golden"},
+		{args: fmt.Sprintf("connect plugin mirror sync confluentinc/dne-connector --dir %s", mirrorDir), fixture: "connect/plugin/mirror/sync-dne.golden", exitCode: 1},
+		{args: fmt.Sprintf("connect plugin mirror sync bad-id-format --dir %s", mirrorDir), fixture: "connect/plugin/mirror/sync-bad-id.golden", exitCode: 1},
+		{args: "connect plugin mirror sync confluentinc/integration-test-plugin", fixture: "connect/plugin/mirror/sync-missing-dir.golden", exitCode: 1},
+
+		{args: fmt.Sprintf("connect plugin install confluentinc/integration-test-plugin:latest --hub-mirror %s --plugin-directory %s --force", mirrorDir, pluginDir), env: []string{"CONFLUENT_HOME=" + confluentHomeEmpty}, fixture: "connect/plugin/install/hub-mirror.golden"},
+		{args: "connect plugin install confluentinc/dne-connector:latest --hub-mirror test/fixtures/input/connect/hub-mirror --dry-run", env: []string{"CONFLUENT_HOME=" + confluentHome733}, fixture: "connect/plugin/install/hub-mirror-dne.golden", exitCode: 1},
+		{args: "connect plugin install confluentinc/integration-test-plugin:latest --hub-mirror test/fixtures/input/connect/hub-mirror --dry-run --force", env: []string{"CONFLUENT_HOME=" + confluentHome733}, fixture: "connect/plugin/install/force.golden"},
+		{args: fmt.Sprintf("connect plugin install confluentinc/integration-test-plugin:latest --hub-mirror %s/mirror --dry-run --force", testserver.TestHubUrl.String()), env: []string{"CONFLUENT_HOME=" + confluentHome733}, fixture: "connect/plugin/install/force.golden"},
+	}
+
+	for _, test := range tests {
+		test.login = "onprem"
+		s.runIntegrationTest(test)
+	}
+
+	for _, manifest := range []string{"0.0.5/manifest.json", "0.1.0/manifest.json", "0.1.0/confluentinc-integration-test-plugin.zip", "latest/manifest.json"} {
+		s.FileExists(filepath.Join(mirrorDir, "confluentinc", "integration-test-plugin", manifest))
+	}
+	s.DirExists(filepath.Join(pluginDir, "confluentinc-integration-test-plugin"))
+}
+
 func (s *CLITestSuite) TestConnect_Autocomplete() {
 	tests := []CLITest{
 		{args: `__complete connect cluster describe ""`, useKafka: "lkc-123", fixture: "connect/cluster/describe-autocomplete.golden"},
@@ -135,6 +135,7 @@ func (s *CLITestSuite) TestKafka() {
 		noSchemaTest := CLITest{args: "kafka topic produce topic-exist --value-format avro --api-key key --api-secret secret", login: "cloud", useKafka: "lkc-create-topic", fixture: "kafka/topic/produce-no-schema.golden", exitCode: 1}
 		tests = append(tests, noSchemaTest)
 	}
+	tests = append(tests, CLITest{args: "kafka topic produce topic-exist --value-format avro --use-latest-version --api-key key --api-secret secret", useKafka: "lkc-create-topic", fixture: "kafka/topic/produce-use-latest-version-auto-register.golden", exitCode: 1})
 
 	resetConfiguration(s.T(), false)
 
@@ -0,0 +1,16 @@
+package test
+
+func (s *CLITestSuite) TestManifest() {
+	tests := []CLITest{
+		{args: "diff --file test/fixtures/input/manifest/topics-and-acls.yaml --cluster lkc-kafka-api-topics", fixture: "diff/cloud.golden"},
+		{args: "diff --file test/fixtures/input/manifest/topics-and-acls.yaml --cluster lkc-kafka-api-topics --prune -o json", fixture: "diff/cloud-json.golden"},
+		{args: "apply --file test/fixtures/input/manifest/topics-and-acls.yaml --cluster lkc-kafka-api-topics --dry-run", fixture: "apply/dry-run-cloud.golden"},
+		{args: "apply --file test/fixtures/input/manifest/topics-and-acls.yaml --cluster lkc-kafka-api-topics", fixture: "apply/cloud.golden"},
+		{args: "diff --file test/fixtures/input/manifest/role-bindings.yaml --cluster lkc-kafka-api-topics", fixture: "diff/role-bindings-cloud.golden", exitCode: 1},
+	}
+
+	for _, test := range tests {
+		test.login = "cloud"
+		s.runIntegrationTest(test)
+	}
+}
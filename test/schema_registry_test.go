@@ -2,6 +2,7 @@ package test
 
 import (
 	"fmt"
+	"os"
 
 	testserver "github.com/confluentinc/cli/v3/test/test-server"
 )
@@ -10,6 +11,8 @@ var (
 	schemaPath   = getInputFixturePath("schema-registry", "schema-example.json")
 	metadataPath = getInputFixturePath("schema-registry", "schema-metadata.json")
 	rulesetPath  = getInputFixturePath("schema-registry", "schema-ruleset.json")
+
+	employeeSchemaPath = getInputFixturePath("schema-registry", "employee.avsc")
 )
 
 func (s *CLITestSuite) TestSchemaRegistryCluster() {
@@ -105,6 +108,10 @@ func (s *CLITestSuite) TestSchemaRegistryExporter() {
 }
 
 func (s *CLITestSuite) TestSchemaRegistrySchema() {
+	downloadDir, err := os.MkdirTemp("", "schema-registry-download")
+	s.NoError(err)
+	defer os.RemoveAll(downloadDir)
+
 	tests := []CLITest{
 		{args: fmt.Sprintf("schema-registry schema create --subject payments --schema %s --environment %s", schemaPath, testserver.SRApiEnvId), fixture: "schema-registry/schema/create.golden"},
 		{args: fmt.Sprintf("schema-registry schema create --subject payments --schema %s --metadata %s --ruleset %s --environment %s", schemaPath, metadataPath, rulesetPath, testserver.SRApiEnvId), fixture: "schema-registry/schema/create.golden"},
@@ -123,6 +130,13 @@ func (s *CLITestSuite) TestSchemaRegistrySchema() {
 		{args: fmt.Sprintf("schema-registry schema describe 1001 --show-references --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/schema/describe-refs-id.golden"},
 		{args: fmt.Sprintf("schema-registry schema describe 1005 --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/schema/describe-with-ruleset.golden"},
 		{args: fmt.Sprintf("schema-registry schema describe --subject lvl0 --version 1 --show-references --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/schema/describe-refs-subject.golden"},
+		{args: fmt.Sprintf("schema-registry schema diff --subject employee --from 1 --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/schema/diff.golden"},
+		{args: fmt.Sprintf("schema-registry schema diff --subject employee --from 1 --to 2 --environment %s -o json", testserver.SRApiEnvId), fixture: "schema-registry/schema/diff-json.golden"},
+		{args: fmt.Sprintf("schema-registry schema diff --subject employee --from latest --schema %s --environment %s", employeeSchemaPath, testserver.SRApiEnvId), fixture: "schema-registry/schema/diff-schema.golden"},
+		{args: fmt.Sprintf("schema-registry schema diff --subject employee --from latest --schema %s --type protobuf --environment %s", employeeSchemaPath, testserver.SRApiEnvId), fixture: "schema-registry/schema/diff-schema-type.golden", exitCode: 1},
+		{args: fmt.Sprintf("schema-registry schema download --subject lvl0 --version 1 --dir %s --environment %s", downloadDir, testserver.SRApiEnvId), fixture: "schema-registry/schema/download.golden"},
+		{args: fmt.Sprintf("schema-registry schema download --subject payments --dir %s --environment %s -o json", downloadDir, testserver.SRApiEnvId), fixture: "schema-registry/schema/download-latest-json.golden"},
+		{args: fmt.Sprintf("schema-registry schema download --subject escape --version 1 --dir %s --environment %s", downloadDir, testserver.SRApiEnvId), fixture: "schema-registry/schema/download-invalid-reference.golden", exitCode: 1},
 		{args: fmt.Sprintf("schema-registry schema list --subject-prefix mysubject-1 --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/schema/list-schemas-subject.golden"},
 		{args: fmt.Sprintf("schema-registry schema list --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/schema/list-schemas-default.golden"},
 	}
@@ -134,7 +148,17 @@ func (s *CLITestSuite) TestSchemaRegistrySchema() {
 }
 
 func (s *CLITestSuite) TestSchemaRegistrySubject() {
+	exportDir, err := os.MkdirTemp("", "schema-registry-export")
+	s.NoError(err)
+	defer os.RemoveAll(exportDir)
+
+	importDir := getInputFixturePath("schema-registry", "subjects")
+
 	tests := []CLITest{
+		{args: fmt.Sprintf("schema-registry subject export --dir %s --environment %s", exportDir, testserver.SRApiEnvId), fixture: "schema-registry/subject/export.golden"},
+		{args: fmt.Sprintf("schema-registry subject import --dir %s --environment %s", importDir, testserver.SRApiEnvId), fixture: "schema-registry/subject/import.golden"},
+		{args: fmt.Sprintf("schema-registry subject import --dir %s --preserve-ids --environment %s -o json", importDir, testserver.SRApiEnvId), fixture: "schema-registry/subject/import-preserve-ids-json.golden"},
+		{args: fmt.Sprintf("schema-registry subject import --dir %s --environment %s", "missing-dir", testserver.SRApiEnvId), fixture: "schema-registry/subject/import-empty-dir.golden", exitCode: 1},
 		{args: fmt.Sprintf("schema-registry subject list --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/subject/list.golden"},
 		{args: fmt.Sprintf("schema-registry subject describe testSubject --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/subject/describe.golden"},
 		{args: fmt.Sprintf("schema-registry subject update testSubject --compatibility backward --environment %s", testserver.SRApiEnvId), fixture: "schema-registry/subject/update-compatibility.golden"},
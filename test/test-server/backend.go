@@ -16,6 +16,7 @@ var (
 	TestKafkaRestProxyUrl = url.URL{Scheme: "http", Host: "127.0.0.1:1025"}
 	TestFlinkGatewayUrl   = url.URL{Scheme: "http", Host: "127.0.0.1:1026"}
 	TestSchemaRegistryUrl = url.URL{Scheme: "http", Host: "127.0.0.1:1027"}
+	TestConnectRestUrl    = url.URL{Scheme: "http", Host: "127.0.0.1:1028"}
 )
 
 // TestBackend consists of the servers for necessary mocked backend services
@@ -28,6 +29,7 @@ type TestBackend struct {
 	mds            *httptest.Server
 	sr             *httptest.Server
 	hub            *httptest.Server
+	connectRest    *httptest.Server
 }
 
 func StartTestBackend(t *testing.T, isAuditLogEnabled bool) *TestBackend {
@@ -39,6 +41,7 @@ func StartTestBackend(t *testing.T, isAuditLogEnabled bool) *TestBackend {
 		mds:            httptest.NewServer(NewMdsRouter(t)),
 		sr:             newTestCloudServer(NewSRRouter(t), TestSchemaRegistryUrl.Host),
 		hub:            newTestCloudServer(NewHubRouter(t), TestHubUrl.Host),
+		connectRest:    newTestCloudServer(NewConnectRestRouter(t), TestConnectRestUrl.Host),
 	}
 }
 
@@ -89,6 +92,9 @@ func (b *TestBackend) Close() {
 	if b.hub != nil {
 		b.hub.Close()
 	}
+	if b.connectRest != nil {
+		b.connectRest.Close()
+	}
 }
 
 func (b *TestBackend) GetCloudUrl() string {
@@ -99,6 +105,10 @@ func (b *TestBackend) GetKafkaRestUrl() string {
 	return b.kafkaRestProxy.URL + "/kafka"
 }
 
+func (b *TestBackend) GetConnectRestUrl() string {
+	return b.connectRest.URL
+}
+
 func (b *TestBackend) GetMdsUrl() string {
 	return b.mds.URL
 }
@@ -32,6 +32,10 @@ var ccloudV2Routes = []route{
 	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}", handleConnector},
 	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/config", handleConnectorConfig},
 	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/pause", handleConnectorPause},
+	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/offsets", handleConnectorOffsets},
+	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/offsets/request", handleConnectorOffsetsRequest},
+	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/offsets/request/status", handleConnectorOffsetsRequestStatus},
+	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/restart", handleConnectorRestart},
 	{"/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/resume", handleConnectorResume},
 	{"/connect/v1/custom-connector-plugins", handleCustomPlugin},
 	{"/connect/v1/custom-connector-plugins/{id}", handleCustomPluginWithId},
@@ -2,7 +2,9 @@ package testserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"slices"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -53,6 +55,65 @@ func handleConnectorResume(_ *testing.T) http.HandlerFunc {
 	}
 }
 
+// Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/restart"
+func handleConnectorRestart(_ *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+var connectorOffsets = []map[string]any{
+	{
+		"partition": map[string]any{"kafka_partition": 0, "kafka_topic": "orders"},
+		"offset":    map[string]any{"kafka_offset": 1000},
+	},
+	{
+		"partition": map[string]any{"kafka_partition": 1, "kafka_topic": "orders"},
+		"offset":    map[string]any{"kafka_offset": 2000},
+	},
+}
+
+// Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/offsets"
+func handleConnectorOffsets(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"id":       "lcc-123",
+			"name":     mux.Vars(r)["connector"],
+			"offsets":  connectorOffsets,
+			"metadata": map[string]any{"observed_at": "2024-01-02T15:04:05Z"},
+		})
+		require.NoError(t, err)
+	}
+}
+
+// Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/offsets/request"
+func handleConnectorOffsetsRequest(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request map[string]any
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+
+		w.WriteHeader(http.StatusAccepted)
+		err = json.NewEncoder(w).Encode(map[string]any{
+			"request": request,
+			"status":  map[string]any{"phase": "PENDING"},
+		})
+		require.NoError(t, err)
+	}
+}
+
+// Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors/{connector}/offsets/request/status"
+func handleConnectorOffsetsRequestStatus(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewEncoder(w).Encode(map[string]any{
+			"request":    map[string]any{"type": "PATCH"},
+			"status":     map[string]any{"phase": "APPLIED"},
+			"applied_at": "2024-01-02T15:04:05Z",
+		})
+		require.NoError(t, err)
+	}
+}
+
 // Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connectors"
 func handleConnectors(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -127,97 +188,60 @@ func handlePlugins(t *testing.T) http.HandlerFunc {
 // Handler for: "/connect/v1/environments/{env}/clusters/{clusters}/connector-plugins/{plugin}/config/validate"
 func handlePluginValidate(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		configs := &[]connectv1.InlineResponse2003Configs{
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("kafka.api.key"),
-					Errors: &[]string{`"kafka.api.key" is required`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Kafka API Key"),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("kafka.api.secret"),
-					Errors: &[]string{`"kafka.api.secret" is required`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Kafka API Secret"),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("topics"),
-					Errors: &[]string{`"topics" is required`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Identifies the topic name."),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("data.format"),
-					Errors: &[]string{`"data.format" is required, Value "null" doesn't belong to the property's "data.format" enum`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Sets the input value format."),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("gcs.credentials.config"),
-					Errors: &[]string{`"gcs.credentials.config" is required`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("GCP service account JSON file."),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("gcs.bucket.name"),
-					Errors: &[]string{`"gcs.bucket.name" is required`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("GCS bucket name."),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("time.interval"),
-					Errors: &[]string{`"data.format" is required, Value "null" doesn't belong to the property's "time.interval" enum`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Partitioning interval of data."),
-					Required:      connectv1.PtrBool(true),
-				},
-			},
-			{
+		var request map[string]string
+		err := json.NewDecoder(r.Body).Decode(&request)
+		require.NoError(t, err)
+
+		definitions := []struct {
+			name              string
+			documentation     string
+			required          bool
+			recommendedValues []string
+		}{
+			{name: "kafka.api.key", documentation: "Kafka API Key", required: true},
+			{name: "kafka.api.secret", documentation: "Kafka API Secret", required: true},
+			{name: "topics", documentation: "Identifies the topic name.", required: true},
+			{name: "data.format", documentation: "Sets the input value format.", required: true, recommendedValues: []string{"AVRO", "JSON", "BYTES"}},
+			{name: "gcs.credentials.config", documentation: "GCP service account JSON file.", required: true},
+			{name: "gcs.bucket.name", documentation: "GCS bucket name.", required: true},
+			{name: "time.interval", documentation: "Partitioning interval of data.", required: true, recommendedValues: []string{"DAILY", "HOURLY"}},
+			{name: "tasks.max", documentation: "Tasks", required: true},
+			{name: "flush.size", documentation: "Commit file size."},
+		}
+
+		configs := make([]connectv1.InlineResponse2003Configs, len(definitions))
+		errorCount := int32(0)
+		for i, definition := range definitions {
+			value, ok := request[definition.name]
+
+			var errors []string
+			if definition.required && !ok {
+				errors = append(errors, fmt.Sprintf(`"%s" is required`, definition.name))
+			} else if ok && len(definition.recommendedValues) > 0 && !slices.Contains(definition.recommendedValues, value) {
+				errors = append(errors, fmt.Sprintf(`Value "%s" doesn't belong to the property's "%s" enum`, value, definition.name))
+			}
+			errorCount += int32(len(errors))
+			recommendedValues := definition.recommendedValues
+
+			configs[i] = connectv1.InlineResponse2003Configs{
 				Value: &connectv1.InlineResponse2003Value{
-					Name:   connectv1.PtrString("tasks.max"),
-					Errors: &[]string{`"tasks.max" is required`},
-				},
-				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Tasks"),
-					Required:      connectv1.PtrBool(true),
+					Name:              connectv1.PtrString(definition.name),
+					Value:             connectv1.PtrString(value),
+					Errors:            &errors,
+					RecommendedValues: &recommendedValues,
 				},
-			},
-			{
-				Value: &connectv1.InlineResponse2003Value{Name: connectv1.PtrString("flush.size")},
 				Definition: &connectv1.InlineResponse2003Definition{
-					Documentation: connectv1.PtrString("Commit file size."),
-					Required:      connectv1.PtrBool(false),
+					Documentation: connectv1.PtrString(definition.documentation),
+					Required:      connectv1.PtrBool(definition.required),
 				},
-			},
+			}
 		}
 
-		err := json.NewEncoder(w).Encode(connectv1.InlineResponse2003{Configs: configs})
+		err = json.NewEncoder(w).Encode(connectv1.InlineResponse2003{
+			Name:       connectv1.PtrString(mux.Vars(r)["plugin"]),
+			ErrorCount: connectv1.PtrInt32(errorCount),
+			Configs:    &configs,
+		})
 		require.NoError(t, err)
 	}
 }
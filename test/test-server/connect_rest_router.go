@@ -0,0 +1,144 @@
+package testserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+var connectRestRoutes = []route{
+	{"/connectors", handleConnectRestConnectors},
+	{"/connectors/{name}", handleConnectRestConnector},
+	{"/connectors/{name}/config", handleConnectRestConnectorConfig},
+	{"/connectors/{name}/pause", handleConnectRestConnectorPauseResume},
+	{"/connectors/{name}/resume", handleConnectRestConnectorPauseResume},
+	{"/connectors/{name}/status", handleConnectRestConnectorStatus},
+}
+
+var connectRestConnectorConfig = map[string]string{
+	"name":            "my-connector",
+	"connector.class": "FileStreamSource",
+	"file":            "/tmp/input.txt",
+	"tasks.max":       "2",
+	"topic":           "lines",
+}
+
+func NewConnectRestRouter(t *testing.T) *mux.Router {
+	router := mux.NewRouter()
+	router.Use(defaultHeaderMiddleware)
+
+	for _, route := range connectRestRoutes {
+		router.HandleFunc(route.path, route.handler(t))
+	}
+
+	return router
+}
+
+// Handler for: "/connectors"
+func handleConnectRestConnectors(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode([]string{"my-connector"})
+			require.NoError(t, err)
+		case http.MethodPost:
+			var body struct {
+				Name   string            `json:"name"`
+				Config map[string]string `json:"config"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+			if body.Name == "my-connector" {
+				writeConnectRestError(t, w, http.StatusConflict, fmt.Sprintf("Connector %s already exists", body.Name))
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			err := json.NewEncoder(w).Encode(map[string]any{"name": body.Name, "config": body.Config, "tasks": []any{}, "type": "sink"})
+			require.NoError(t, err)
+		}
+	}
+}
+
+// Handler for: "/connectors/{name}"
+func handleConnectRestConnector(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		if name != "my-connector" {
+			writeConnectRestError(t, w, http.StatusNotFound, fmt.Sprintf("Connector %s not found", name))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			err := json.NewEncoder(w).Encode(map[string]any{"name": name, "config": connectRestConnectorConfig, "type": "source"})
+			require.NoError(t, err)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+// Handler for: "/connectors/{name}/config"
+func handleConnectRestConnectorConfig(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		if name != "my-connector" {
+			writeConnectRestError(t, w, http.StatusNotFound, fmt.Sprintf("Connector %s not found", name))
+			return
+		}
+
+		var config map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&config))
+		require.Equal(t, "FileStreamSource", config["connector.class"])
+
+		err := json.NewEncoder(w).Encode(map[string]any{"name": name, "config": config, "type": "source"})
+		require.NoError(t, err)
+	}
+}
+
+// Handler for: "/connectors/{name}/pause" and "/connectors/{name}/resume"
+func handleConnectRestConnectorPauseResume(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		if name != "my-connector" {
+			writeConnectRestError(t, w, http.StatusNotFound, fmt.Sprintf("Connector %s not found", name))
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// Handler for: "/connectors/{name}/status"
+func handleConnectRestConnectorStatus(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		if name != "my-connector" {
+			writeConnectRestError(t, w, http.StatusNotFound, fmt.Sprintf("Connector %s not found", name))
+			return
+		}
+
+		status := map[string]any{
+			"name":      name,
+			"connector": map[string]any{"state": "RUNNING", "worker_id": "10.0.0.1:8083"},
+			"tasks": []map[string]any{
+				{"id": 0, "state": "RUNNING", "worker_id": "10.0.0.1:8083"},
+				{"id": 1, "state": "FAILED", "worker_id": "10.0.0.2:8083", "trace": "org.apache.kafka.connect.errors.ConnectException"},
+			},
+			"type": "source",
+		}
+		err := json.NewEncoder(w).Encode(status)
+		require.NoError(t, err)
+	}
+}
+
+func writeConnectRestError(t *testing.T, w http.ResponseWriter, code int, message string) {
+	w.WriteHeader(code)
+	err := json.NewEncoder(w).Encode(map[string]any{"error_code": code, "message": message})
+	require.NoError(t, err)
+}
@@ -42,7 +42,7 @@ func handleHubPlugin(t *testing.T) http.HandlerFunc {
 		vars := mux.Vars(r)
 		id := vars["id"]
 		if id == "dne-connector" || vars["owner"] != "confluentinc" {
-			err := writeResourceNotFoundError(w)
+			err := writeNotFoundError(w)
 			require.NoError(t, err)
 			return
 		}
@@ -79,7 +79,7 @@ func handleHubPluginVersion(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		if vars["id"] == "dne-connector" || vars["owner"] != "confluentinc" || vars["version"] != "0.0.5" {
-			err := writeResourceNotFoundError(w)
+			err := writeNotFoundError(w)
 			require.NoError(t, err)
 			return
 		}
@@ -111,7 +111,7 @@ func handleHubPluginArchive(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		if vars["owner"] != "confluentinc" || vars["id"] != "integration-test-plugin" || vars["version"] != "0.1.0" || vars["archive"] != "confluentinc-integration-test-plugin.zip" {
-			err := writeResourceNotFoundError(w)
+			err := writeNotFoundError(w)
 			require.NoError(t, err)
 			return
 		}
@@ -1,11 +1,15 @@
 package testserver
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/gorilla/mux"
 )
 
+// hubMirrorDir is served at "/mirror" to test installing plugins from a Confluent Hub mirror hosted by a file server.
+const hubMirrorDir = "test/fixtures/input/connect/hub-mirror"
+
 var hubRoutes = []route{
 	{"/api/plugins/{owner}/{id}", handleHubPlugin},
 	{"/api/plugins/{owner}/{id}/versions/{version}", handleHubPluginVersion},
@@ -20,5 +24,7 @@ func NewHubRouter(t *testing.T) *mux.Router {
 		router.HandleFunc(route.path, route.handler(t))
 	}
 
+	router.PathPrefix("/mirror/").Handler(http.StripPrefix("/mirror/", http.FileServer(http.Dir(hubMirrorDir))))
+
 	return router
 }
@@ -97,6 +97,11 @@ func handleSRSubject(t *testing.T) http.HandlerFunc {
 	}
 }
 
+var employeeSchemas = map[int32]string{
+	1: `{"type":"record","name":"Employee","fields":[{"name":"name","type":"string"},{"name":"age","type":"int"},{"name":"team","type":"string","default":"none"}]}`,
+	2: `{"type":"record","name":"Employee","fields":[{"name":"name","type":"string"},{"name":"age","type":"long"},{"name":"team","type":"string","default":"engineering"},{"name":"email","type":["null","string"],"default":null}]}`,
+}
+
 // Handler for: "/subjects/{subject}/versions/{version}"
 func handleSRSubjectVersion(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -107,6 +112,15 @@ func handleSRSubjectVersion(t *testing.T) http.HandlerFunc {
 			if versionStr == "latest" {
 				subject := vars["subject"]
 				switch subject {
+				case "employee":
+					err := json.NewEncoder(w).Encode(srsdk.Schema{
+						Subject:    subject,
+						Version:    2,
+						Id:         1102,
+						SchemaType: "AVRO",
+						Schema:     employeeSchemas[2],
+					})
+					require.NoError(t, err)
 				case "topic2-value":
 					err := json.NewEncoder(w).Encode(srsdk.Schema{
 						Subject:    subject,
@@ -131,6 +145,9 @@ func handleSRSubjectVersion(t *testing.T) http.HandlerFunc {
 				subject := vars["subject"]
 				schema := srsdk.Schema{Subject: subject, Version: int32(version64), SchemaType: "AVRO"}
 				switch subject {
+				case "employee":
+					schema.Id = 1100 + int32(version64)
+					schema.Schema = employeeSchemas[int32(version64)]
 				case "lvl0":
 					schema.Id = 1001
 					schema.Schema = "schema0"
@@ -170,6 +187,14 @@ func handleSRSubjectVersion(t *testing.T) http.HandlerFunc {
 					schema.Id = 1004
 					schema.Schema = "schema2"
 					schema.References = []srsdk.SchemaReference{}
+				case "escape":
+					schema.Id = 1006
+					schema.Schema = "schema"
+					schema.References = []srsdk.SchemaReference{{
+						Name:    "../escape",
+						Subject: "lvl2",
+						Version: 1,
+					}}
 				default:
 					schema.Id = 10
 					schema.Schema = `{"schema":1}`
@@ -439,11 +464,23 @@ func handleSRSubjectConfig(t *testing.T) http.HandlerFunc {
 // Handler for: "/mode/{subject}"
 func handleSRSubjectMode(t *testing.T) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		req := &srsdk.ModeUpdateRequest{}
-		err := json.NewDecoder(r.Body).Decode(req)
-		require.NoError(t, err)
-		err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
-		require.NoError(t, err)
+		switch r.Method {
+		case http.MethodPut:
+			req := &srsdk.ModeUpdateRequest{}
+			err := json.NewDecoder(r.Body).Decode(req)
+			require.NoError(t, err)
+			err = json.NewEncoder(w).Encode(srsdk.ModeUpdateRequest{Mode: req.Mode})
+			require.NoError(t, err)
+		case http.MethodGet:
+			if mux.Vars(r)["subject"] != "mysubject-1" {
+				w.WriteHeader(http.StatusNotFound)
+				_, err := w.Write([]byte(`{"error_code":40409,"message":"Subject not found"}`))
+				require.NoError(t, err)
+				return
+			}
+			err := json.NewEncoder(w).Encode(srsdk.Mode{Mode: "READONLY"})
+			require.NoError(t, err)
+		}
 	}
 }
 
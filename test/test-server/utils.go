@@ -250,6 +250,11 @@ func writeResourceNotFoundError(w http.ResponseWriter) error {
 	return writeErrorJson(w, "resource not found")
 }
 
+func writeNotFoundError(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotFound)
+	return writeErrorJson(w, "resource not found")
+}
+
 func writeInvalidRoleNameError(w http.ResponseWriter, roleName string) error {
 	w.WriteHeader(http.StatusBadRequest)
 	return writeErrorJson(w, fmt.Sprintf("Invalid role name : %s", roleName))